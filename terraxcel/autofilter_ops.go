@@ -0,0 +1,66 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// autofilter filters and sorts the rows of a range, a sheet has at most one.
+// The first row of the range holds the filter buttons.
+type autofilter struct {
+	WorkbookID string         `json:"workbook_id"`
+	SheetID    string         `json:"sheet_id"`
+	Range      string         `json:"range"`
+	Filters    []columnFilter `json:"filters,omitempty"`
+	Sort       []sortKey      `json:"sort,omitempty"`
+}
+
+// columnFilter hides the rows not matching either the values or the
+// conditions of a column.
+type columnFilter struct {
+	Column     string            `json:"column"`
+	Values     []string          `json:"values,omitempty"`
+	Conditions []filterCondition `json:"conditions,omitempty"`
+	MatchAll   *bool             `json:"match_all,omitempty"`
+}
+
+type filterCondition struct {
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+type sortKey struct {
+	Column     string `json:"column"`
+	Descending *bool  `json:"descending,omitempty"`
+}
+
+func autofilterEndpoint(filter *autofilter) string {
+	return sheetEndpoint(filter.WorkbookID, filter.SheetID) + "/autofilter"
+}
+
+func readAutofilter(c *client.Client, filter *autofilter) (*autofilter, error) {
+	read := &autofilter{}
+	err := doRequest(c, http.MethodGet, autofilterEndpoint(filter), nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+// updateAutofilter replaces the autofilter of the sheet, and applies its
+// filters and sort to the rows.
+func updateAutofilter(c *client.Client, filter *autofilter) (*autofilter, error) {
+	updated := &autofilter{}
+	err := doRequest(c, http.MethodPut, autofilterEndpoint(filter), filter, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// deleteAutofilter removes the autofilter and shows the filtered rows, the
+// rows keep their sort order.
+func deleteAutofilter(c *client.Client, filter *autofilter) error {
+	return doRequest(c, http.MethodDelete, autofilterEndpoint(filter), nil, nil, http.StatusOK)
+}
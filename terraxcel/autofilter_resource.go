@@ -0,0 +1,410 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &autofilterResource{}
+	_ resource.ResourceWithConfigure      = &autofilterResource{}
+	_ resource.ResourceWithValidateConfig = &autofilterResource{}
+)
+
+// maxFilterConditions is the number of custom conditions Excel allows per
+// column.
+const maxFilterConditions = 2
+
+// NewAutofilterResource is a helper function to simplify the provider implementation.
+func NewAutofilterResource() resource.Resource {
+	return &autofilterResource{}
+}
+
+type autofilterResource struct {
+	client *client.Client
+}
+
+type autofilterResourceModel struct {
+	ID          types.String        `tfsdk:"id"`
+	LastUpdated types.String        `tfsdk:"last_updated"`
+	WorkbookID  types.String        `tfsdk:"workbook_id"`
+	SheetID     types.String        `tfsdk:"sheet_id"`
+	Range       types.String        `tfsdk:"range"`
+	Filters     []columnFilterModel `tfsdk:"filters"`
+	Sort        []sortKeyModel      `tfsdk:"sort"`
+}
+
+type columnFilterModel struct {
+	Column     types.String           `tfsdk:"column"`
+	Values     []types.String         `tfsdk:"values"`
+	Conditions []filterConditionModel `tfsdk:"conditions"`
+	MatchAll   types.Bool             `tfsdk:"match_all"`
+}
+
+type filterConditionModel struct {
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+type sortKeyModel struct {
+	Column     types.String `tfsdk:"column"`
+	Descending types.Bool   `tfsdk:"descending"`
+}
+
+func (m *autofilterResourceModel) expand() *autofilter {
+	filter := &autofilter{
+		WorkbookID: m.WorkbookID.ValueString(),
+		SheetID:    m.SheetID.ValueString(),
+		Range:      m.Range.ValueString(),
+	}
+
+	for _, columnFilterModel := range m.Filters {
+		result := columnFilter{
+			Column:   columnFilterModel.Column.ValueString(),
+			Values:   expandStrings(columnFilterModel.Values),
+			MatchAll: columnFilterModel.MatchAll.ValueBoolPointer(),
+		}
+		for _, condition := range columnFilterModel.Conditions {
+			result.Conditions = append(result.Conditions, filterCondition{
+				Operator: condition.Operator.ValueString(),
+				Value:    condition.Value.ValueString(),
+			})
+		}
+		filter.Filters = append(filter.Filters, result)
+	}
+
+	for _, key := range m.Sort {
+		filter.Sort = append(filter.Sort, sortKey{
+			Column:     key.Column.ValueString(),
+			Descending: key.Descending.ValueBoolPointer(),
+		})
+	}
+
+	return filter
+}
+
+func (m *autofilterResourceModel) flatten(filter *autofilter) {
+	m.ID = types.StringValue(filter.SheetID)
+	m.Range = types.StringValue(filter.Range)
+
+	m.Filters = nil
+	for _, columnFilter := range filter.Filters {
+		result := columnFilterModel{
+			Column:   types.StringValue(columnFilter.Column),
+			Values:   flattenStrings(columnFilter.Values),
+			MatchAll: types.BoolPointerValue(columnFilter.MatchAll),
+		}
+		for _, condition := range columnFilter.Conditions {
+			result.Conditions = append(result.Conditions, filterConditionModel{
+				Operator: types.StringValue(condition.Operator),
+				Value:    types.StringValue(condition.Value),
+			})
+		}
+		m.Filters = append(m.Filters, result)
+	}
+
+	m.Sort = nil
+	for _, key := range filter.Sort {
+		m.Sort = append(m.Sort, sortKeyModel{
+			Column:     types.StringValue(key.Column),
+			Descending: types.BoolPointerValue(key.Descending),
+		})
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *autofilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_autofilter"
+}
+
+// Schema defines the schema for the resource.
+func (r *autofilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			// the id of the sheet, a sheet has at most one autofilter
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// the first row of the range holds the filter buttons
+			"range": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					cellReference(true),
+				},
+			},
+			"filters": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"column": schema.StringAttribute{
+							Required: true,
+						},
+						// rows with one of the values are shown
+						"values": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"conditions": schema.ListNestedAttribute{
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"operator": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringOneOf("equal", "not_equal", "greater_than", "greater_than_or_equal", "less_than",
+												"less_than_or_equal", "begins_with", "ends_with", "contains", "not_contains"),
+										},
+									},
+									"value": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						// rows must match both conditions instead of either
+						"match_all": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			// sort keys, the first key is sorted on first
+			"sort": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"column": schema.StringAttribute{
+							Required: true,
+						},
+						"descending": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure the filters and sort keys are on columns of the
+// range, and that every filter has either values or conditions.
+func (r *autofilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config autofilterResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invalid := func(attribute path.Path, message string) {
+		resp.Diagnostics.AddAttributeError(attribute, "Invalid autofilter", message)
+	}
+
+	filterRange, err := parseCellRange(config.Range.ValueString())
+	knownRange := !config.Range.IsUnknown() && err == nil
+
+	// checks that a column is a single column within the range
+	checkColumn := func(attribute path.Path, column types.String) {
+		if column.IsUnknown() {
+			return
+		}
+
+		number, err := columnNumber(column.ValueString())
+		if err != nil {
+			invalid(attribute, fmt.Sprintf("%s, column must be a column like B", err))
+			return
+		}
+
+		if knownRange && (number < filterRange.FirstColumn || number > filterRange.LastColumn) {
+			invalid(attribute, fmt.Sprintf("column %s is not in range %s", column.ValueString(), config.Range.ValueString()))
+		}
+	}
+
+	filtered := map[string]bool{}
+	for i, filter := range config.Filters {
+		filterPath := path.Root("filters").AtListIndex(i)
+		checkColumn(filterPath.AtName("column"), filter.Column)
+
+		column := strings.ToUpper(filter.Column.ValueString())
+		if !filter.Column.IsUnknown() && filtered[column] {
+			invalid(filterPath.AtName("column"), fmt.Sprintf("column %s is filtered more than once", column))
+		}
+		filtered[column] = true
+
+		if (filter.Values == nil) == (filter.Conditions == nil) {
+			invalid(filterPath, "a filter requires either values or conditions")
+		}
+		if len(filter.Conditions) > maxFilterConditions {
+			invalid(filterPath.AtName("conditions"), fmt.Sprintf("a filter can have at most %d conditions", maxFilterConditions))
+		}
+		if !filter.MatchAll.IsNull() && len(filter.Conditions) < maxFilterConditions {
+			invalid(filterPath.AtName("match_all"), fmt.Sprintf("match_all can only be set for filters with %d conditions", maxFilterConditions))
+		}
+	}
+
+	sorted := map[string]bool{}
+	for i, key := range config.Sort {
+		keyPath := path.Root("sort").AtListIndex(i)
+		checkColumn(keyPath.AtName("column"), key.Column)
+
+		column := strings.ToUpper(key.Column.ValueString())
+		if !key.Column.IsUnknown() && sorted[column] {
+			invalid(keyPath.AtName("column"), fmt.Sprintf("column %s is sorted on more than once", column))
+		}
+		sorted[column] = true
+	}
+}
+
+func (r *autofilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan autofilterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// creates the autofilter with help of the client
+	filter, err := updateAutofilter(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating autofilter",
+			"Could not create autofilter for range "+plan.Range.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(filter)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *autofilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state autofilterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed autofilter from client
+	filter, err := readAutofilter(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading autofilter",
+			"Could not read autofilter of sheet with ID "+state.SheetID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(filter)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *autofilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state autofilterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteAutofilter(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting autofilter",
+			"Could not delete autofilter, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the autofilter
+func (r *autofilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan autofilterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := updateAutofilter(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating autofilter",
+			"Could not update autofilter, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(filter)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *autofilterResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
@@ -0,0 +1,116 @@
+package terraxcel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAutofilterValidateConfig(t *testing.T) {
+	valuesFilter := func(column string) columnFilterModel {
+		return columnFilterModel{Column: types.StringValue(column), Values: flattenStrings([]string{"North"})}
+	}
+	condition := filterConditionModel{Operator: types.StringValue("greater_than"), Value: types.StringValue("0")}
+	sortKey := func(column string) sortKeyModel {
+		return sortKeyModel{Column: types.StringValue(column)}
+	}
+
+	tests := []struct {
+		name        string
+		filterRange types.String
+		filters     []columnFilterModel
+		sort        []sortKeyModel
+		valid       bool
+	}{
+		{
+			name:        "filter and sort",
+			filterRange: types.StringValue("A1:F40"),
+			filters:     []columnFilterModel{valuesFilter("B")},
+			sort:        []sortKeyModel{sortKey("F"), sortKey("A")},
+			valid:       true,
+		},
+		{
+			name:        "two conditions matching all",
+			filterRange: types.StringValue("A1:F40"),
+			filters: []columnFilterModel{
+				{Column: types.StringValue("E"), Conditions: []filterConditionModel{condition, condition}, MatchAll: types.BoolValue(true)},
+			},
+			valid: true,
+		},
+		{
+			name:        "column outside of the range",
+			filterRange: types.StringValue("A1:F40"),
+			filters:     []columnFilterModel{valuesFilter("G")},
+		},
+		{
+			name:        "unknown range",
+			filterRange: types.StringUnknown(),
+			filters:     []columnFilterModel{valuesFilter("G")},
+			valid:       true,
+		},
+		{
+			name:        "not a column",
+			filterRange: types.StringValue("A1:F40"),
+			filters:     []columnFilterModel{valuesFilter("B2")},
+		},
+		{
+			name:        "column filtered twice",
+			filterRange: types.StringValue("A1:F40"),
+			filters:     []columnFilterModel{valuesFilter("B"), valuesFilter("b")},
+		},
+		{
+			name:        "filter without values or conditions",
+			filterRange: types.StringValue("A1:F40"),
+			filters:     []columnFilterModel{{Column: types.StringValue("B")}},
+		},
+		{
+			name:        "filter with values and conditions",
+			filterRange: types.StringValue("A1:F40"),
+			filters: []columnFilterModel{
+				{Column: types.StringValue("B"), Values: flattenStrings([]string{"North"}), Conditions: []filterConditionModel{condition}},
+			},
+		},
+		{
+			name:        "three conditions",
+			filterRange: types.StringValue("A1:F40"),
+			filters: []columnFilterModel{
+				{Column: types.StringValue("E"), Conditions: []filterConditionModel{condition, condition, condition}},
+			},
+		},
+		{
+			name:        "match all with one condition",
+			filterRange: types.StringValue("A1:F40"),
+			filters: []columnFilterModel{
+				{Column: types.StringValue("E"), Conditions: []filterConditionModel{condition}, MatchAll: types.BoolValue(false)},
+			},
+		},
+		{
+			name:        "sorted twice",
+			filterRange: types.StringValue("A1:F40"),
+			sort:        []sortKeyModel{sortKey("F"), sortKey("f")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &autofilterResource{}
+			s := resourceSchema(t, r)
+			config := autofilterResourceModel{
+				WorkbookID: types.StringValue("wb-1"),
+				SheetID:    types.StringValue("sheet-1"),
+				Range:      test.filterRange,
+				Filters:    test.filters,
+				Sort:       test.sort,
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newConfig(t, s, config)}, resp)
+
+			if valid := !resp.Diagnostics.HasError(); valid != test.valid {
+				t.Errorf("valid = %v, want %v: %v", valid, test.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
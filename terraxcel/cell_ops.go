@@ -0,0 +1,47 @@
+package terraxcel
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Deathfireofdoom/excel-client-go/pkg/models"
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+func cellEndpoint(workbookID, sheetID, cellID string) string {
+	return sheetEndpoint(workbookID, sheetID) + "/cell/" + cellID
+}
+
+// readCell reads a cell with doRequest, client.ReadCell panics as it sends
+// its request without a body.
+func readCell(c *client.Client, workbookID, sheetID, cellID string) (*models.Cell, error) {
+	cell := &models.Cell{}
+	err := doRequest(c, http.MethodGet, cellEndpoint(workbookID, sheetID, cellID), nil, cell, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return cell, nil
+}
+
+// deleteCell replaces client.DeleteCell for the same reason as readCell.
+func deleteCell(c *client.Client, cell *models.Cell) error {
+	return doRequest(c, http.MethodDelete, cellEndpoint(cell.WorkbookID, cell.SheetID, cell.ID), nil, nil, http.StatusOK)
+}
+
+// cellValue converts the value of a cell decoded from json to the string of
+// the value attribute.
+func cellValue(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	default:
+		return fmt.Sprint(value)
+	}
+}
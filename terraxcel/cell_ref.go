@@ -0,0 +1,269 @@
+package terraxcel
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Limits of a worksheet in the xlsx format.
+const (
+	maxColumn = 16384
+	maxRow    = 1048576
+)
+
+// maxNameLength is the longest name of a table or defined name.
+const maxNameLength = 255
+
+// maxHeaderFooterLength is the longest header or footer of a printed page,
+// including the codes.
+const maxHeaderFooterLength = 255
+
+// headerFooterCodes are the letters that follow an & in a header or footer,
+// e.g. &P for the page number and &D for the date.
+const headerFooterCodes = "LCRPNDTZFAGBIUESXYOH"
+
+var (
+	cellReferenceRegexp = regexp.MustCompile(`^\$?([A-Za-z]{1,3})\$?([0-9]+)$`)
+	r1c1ReferenceRegexp = regexp.MustCompile(`^([Rr][0-9]*([Cc][0-9]*)?|[Cc][0-9]*)$`)
+)
+
+// cellRange is a rectangle of cells, columns and rows are numbered from 1.
+type cellRange struct {
+	FirstColumn int
+	FirstRow    int
+	LastColumn  int
+	LastRow     int
+}
+
+// columnNumber converts a column name, e.g. "AB", to its number.
+func columnNumber(name string) (int, error) {
+	if name == "" {
+		return 0, fmt.Errorf("empty column name")
+	}
+
+	number := 0
+	for _, char := range strings.ToUpper(name) {
+		if char < 'A' || char > 'Z' {
+			return 0, fmt.Errorf("invalid column name %q", name)
+		}
+		number = number*26 + int(char-'A'+1)
+	}
+
+	if number > maxColumn {
+		return 0, fmt.Errorf("column %s is after the last column XFD", name)
+	}
+	return number, nil
+}
+
+// columnName converts a column number to its name, e.g. 28 to "AB".
+func columnName(number int) string {
+	name := ""
+	for number > 0 {
+		number--
+		name = string(rune('A'+number%26)) + name
+		number /= 26
+	}
+	return name
+}
+
+// parseCellReference parses an A1 reference, absolute references such as
+// $A$1 are allowed.
+func parseCellReference(reference string) (column, row int, err error) {
+	matches := cellReferenceRegexp.FindStringSubmatch(reference)
+	if matches == nil {
+		return 0, 0, fmt.Errorf("%q is not a cell reference like A1", reference)
+	}
+
+	column, err = columnNumber(matches[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	row, err = strconv.Atoi(matches[2])
+	if err != nil || row < 1 || row > maxRow {
+		return 0, 0, fmt.Errorf("row %s in %q is out of range", matches[2], reference)
+	}
+
+	return column, row, nil
+}
+
+// parseCellRange parses a range like A1:C3, a single cell is a range of one
+// cell.
+func parseCellRange(reference string) (cellRange, error) {
+	first, last, isRange := strings.Cut(reference, ":")
+	if !isRange {
+		last = first
+	}
+
+	firstColumn, firstRow, err := parseCellReference(first)
+	if err != nil {
+		return cellRange{}, err
+	}
+	lastColumn, lastRow, err := parseCellReference(last)
+	if err != nil {
+		return cellRange{}, err
+	}
+
+	return cellRange{
+		FirstColumn: min(firstColumn, lastColumn),
+		FirstRow:    min(firstRow, lastRow),
+		LastColumn:  max(firstColumn, lastColumn),
+		LastRow:     max(firstRow, lastRow),
+	}, nil
+}
+
+// overlaps reports whether the ranges share at least one cell.
+func (r cellRange) overlaps(other cellRange) bool {
+	return r.FirstColumn <= other.LastColumn && other.FirstColumn <= r.LastColumn &&
+		r.FirstRow <= other.LastRow && other.FirstRow <= r.LastRow
+}
+
+// anchor returns the top left cell of the range.
+func (r cellRange) anchor() string {
+	return columnName(r.FirstColumn) + strconv.Itoa(r.FirstRow)
+}
+
+// parseColumnSpan parses columns like B or B:D, and returns the first and
+// last column number.
+func parseColumnSpan(span string) (first, last int, err error) {
+	firstName, lastName, isSpan := strings.Cut(span, ":")
+	if !isSpan {
+		lastName = firstName
+	}
+
+	first, err = columnNumber(strings.TrimPrefix(firstName, "$"))
+	if err != nil {
+		return 0, 0, err
+	}
+	last, err = columnNumber(strings.TrimPrefix(lastName, "$"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return min(first, last), max(first, last), nil
+}
+
+// parseRowSpan parses rows like 3 or 3:10, and returns the first and last
+// row number.
+func parseRowSpan(span string) (first, last int, err error) {
+	firstRow, lastRow, isSpan := strings.Cut(span, ":")
+	if !isSpan {
+		lastRow = firstRow
+	}
+
+	first, err = rowNumber(firstRow)
+	if err != nil {
+		return 0, 0, err
+	}
+	last, err = rowNumber(lastRow)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return min(first, last), max(first, last), nil
+}
+
+func rowNumber(name string) (int, error) {
+	number, err := strconv.Atoi(strings.TrimPrefix(name, "$"))
+	if err != nil || number < 1 || number > maxRow {
+		return 0, fmt.Errorf("%q is not a row between 1 and %d", name, maxRow)
+	}
+	return number, nil
+}
+
+// validateExcelName checks the rules Excel has for the names of tables and
+// defined names. Names start with a letter, underscore or backslash, contain
+// only letters, digits, underscores, periods and backslashes, and can not be
+// a cell reference.
+func validateExcelName(name string) error {
+	if name == "" {
+		return fmt.Errorf("name can not be empty")
+	}
+	chars := []rune(name)
+	if len(chars) > maxNameLength {
+		return fmt.Errorf("name %q is longer than %d characters", name, maxNameLength)
+	}
+
+	for i, char := range chars {
+		if unicode.IsLetter(char) || char == '_' || char == '\\' {
+			continue
+		}
+		if i > 0 && (unicode.IsDigit(char) || char == '.') {
+			continue
+		}
+		return fmt.Errorf("name %q can not contain %q at position %d", name, char, i+1)
+	}
+
+	if _, _, err := parseCellReference(name); err == nil {
+		return fmt.Errorf("name %q can not be a cell reference", name)
+	}
+	if r1c1ReferenceRegexp.MatchString(name) {
+		return fmt.Errorf("name %q can not be an R1C1 reference", name)
+	}
+
+	return nil
+}
+
+// validateSheetReference checks that a reference is a cell or range in a
+// sheet, like Summary!A1 or 'Q3 Sales'!A1:C3, or a defined name.
+func validateSheetReference(reference string) error {
+	sheet, cells, inSheet := strings.Cut(reference, "!")
+	if !inSheet {
+		return validateExcelName(reference)
+	}
+
+	if sheet == "" || sheet == "''" {
+		return fmt.Errorf("%q is missing the sheet name", reference)
+	}
+	if _, err := parseCellRange(cells); err != nil {
+		return fmt.Errorf("%q must refer to cells like Summary!A1: %s", reference, err)
+	}
+	return nil
+}
+
+// validateHeaderFooter checks the codes of a header or footer. Codes are an &
+// followed by a letter like &P, a font size like &12, a font like
+// &"Arial,Bold" or a color like &KFF0000. && prints an ampersand.
+func validateHeaderFooter(text string) error {
+	if utf8.RuneCountInString(text) > maxHeaderFooterLength {
+		return fmt.Errorf("%q is longer than %d characters", text, maxHeaderFooterLength)
+	}
+
+	for i := 0; i < len(text); i++ {
+		if text[i] != '&' {
+			continue
+		}
+		i++
+		if i == len(text) {
+			return fmt.Errorf("%q ends with a single &, use && for an ampersand", text)
+		}
+
+		switch code := text[i]; {
+		case code == '&' || strings.IndexByte(headerFooterCodes, code) >= 0:
+		case code == '"':
+			end := strings.IndexByte(text[i+1:], '"')
+			if end < 0 {
+				return fmt.Errorf("the font of %q is not closed with a \"", text)
+			}
+			i += end + 1
+		case code >= '0' && code <= '9':
+			for i+1 < len(text) && text[i+1] >= '0' && text[i+1] <= '9' {
+				i++
+			}
+		case code == 'K':
+			if i+6 >= len(text) || !hexColorRegexp.MatchString(text[i+1:i+7]) {
+				return fmt.Errorf("&K in %q must be followed by a color like FF0000", text)
+			}
+			i += 6
+		default:
+			char, _ := utf8.DecodeRuneInString(text[i:])
+			return fmt.Errorf("&%c in %q is not a header or footer code", char, text)
+		}
+	}
+
+	return nil
+}
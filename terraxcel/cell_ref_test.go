@@ -0,0 +1,190 @@
+package terraxcel
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateExcelName(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"Sales", true},
+		{"_total", true},
+		{"\\path", true},
+		{"Tax.Rate2", true},
+		{"Rate", true},
+		{"Cost", true},
+		{"Q3_Sales", true},
+		{"Ümsatz", true},
+		{"", false},
+		{"2023", false},
+		{"Sales Total", false},
+		{".hidden", false},
+		{"A1", false},
+		{"$B$2", false},
+		{"XFD1048576", false},
+		{"R", false},
+		{"c", false},
+		{"R1", false},
+		{"C2", false},
+		{"RC", false},
+		{"R1C1", false},
+		{"r10c2", false},
+		{strings.Repeat("a", maxNameLength), true},
+		{strings.Repeat("a", maxNameLength+1), false},
+	}
+
+	for _, test := range tests {
+		err := validateExcelName(test.name)
+		if (err == nil) != test.valid {
+			t.Errorf("validateExcelName(%q) = %v, want valid %v", test.name, err, test.valid)
+		}
+	}
+}
+
+func TestParseCellReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		column    int
+		row       int
+		valid     bool
+	}{
+		{"A1", 1, 1, true},
+		{"b2", 2, 2, true},
+		{"$AB$12", 28, 12, true},
+		{"XFD1048576", maxColumn, maxRow, true},
+		{"XFE1", 0, 0, false},
+		{"A1048577", 0, 0, false},
+		{"A0", 0, 0, false},
+		{"A", 0, 0, false},
+		{"1", 0, 0, false},
+		{"AAAA1", 0, 0, false},
+		{"A1:B2", 0, 0, false},
+		{"", 0, 0, false},
+	}
+
+	for _, test := range tests {
+		column, row, err := parseCellReference(test.reference)
+		if (err == nil) != test.valid {
+			t.Errorf("parseCellReference(%q) = %v, want valid %v", test.reference, err, test.valid)
+			continue
+		}
+		if column != test.column || row != test.row {
+			t.Errorf("parseCellReference(%q) = %d, %d, want %d, %d", test.reference, column, row, test.column, test.row)
+		}
+	}
+}
+
+func TestParseCellRange(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      cellRange
+		valid     bool
+	}{
+		{"A1:C3", cellRange{1, 1, 3, 3}, true},
+		{"B2", cellRange{2, 2, 2, 2}, true},
+		{"C3:A1", cellRange{1, 1, 3, 3}, true},
+		{"A3:C1", cellRange{1, 1, 3, 3}, true},
+		{"$A$1:$B$2", cellRange{1, 1, 2, 2}, true},
+		{"A1:", cellRange{}, false},
+		{":C3", cellRange{}, false},
+		{"A1:C3:D4", cellRange{}, false},
+		{"A:C", cellRange{}, false},
+	}
+
+	for _, test := range tests {
+		got, err := parseCellRange(test.reference)
+		if (err == nil) != test.valid {
+			t.Errorf("parseCellRange(%q) = %v, want valid %v", test.reference, err, test.valid)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("parseCellRange(%q) = %+v, want %+v", test.reference, got, test.want)
+		}
+	}
+}
+
+func TestCellRangeOverlaps(t *testing.T) {
+	tests := []struct {
+		first  string
+		second string
+		want   bool
+	}{
+		{"A1:C3", "B2:D4", true},
+		{"A1:C3", "C3", true},
+		{"A1:C3", "A1:C3", true},
+		{"A1:D4", "B2:C3", true},
+		{"A1:C3", "D1:F3", false},
+		{"A1:C3", "A4:C6", false},
+		{"A1:B2", "C3:D4", false},
+		{"A1:A10", "B1:B10", false},
+	}
+
+	for _, test := range tests {
+		first, _ := parseCellRange(test.first)
+		second, _ := parseCellRange(test.second)
+		if got := first.overlaps(second); got != test.want {
+			t.Errorf("%s overlaps %s = %v, want %v", test.first, test.second, got, test.want)
+		}
+		if got := second.overlaps(first); got != test.want {
+			t.Errorf("%s overlaps %s = %v, want %v", test.second, test.first, got, test.want)
+		}
+	}
+}
+
+func TestValidateSheetReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		valid     bool
+	}{
+		{"Summary!A1", true},
+		{"Summary!$A$1:$C$3", true},
+		{"'Q3 Sales'!A1:C3", true},
+		{"TaxRate", true},
+		{"!A1", false},
+		{"''!A1", false},
+		{"Summary!", false},
+		{"Summary!TaxRate", false},
+		{"A1", false},
+		{"Tax Rate", false},
+	}
+
+	for _, test := range tests {
+		err := validateSheetReference(test.reference)
+		if (err == nil) != test.valid {
+			t.Errorf("validateSheetReference(%q) = %v, want valid %v", test.reference, err, test.valid)
+		}
+	}
+}
+
+func TestValidateHeaderFooter(t *testing.T) {
+	tests := []struct {
+		text  string
+		valid bool
+	}{
+		{"", true},
+		{"Quarterly report", true},
+		{"&LConfidential&RPage &P of &N", true},
+		{"&C&\"Arial,Bold\"&14Report &D &T", true},
+		{"A && B", true},
+		{"&KFF0000Red", true},
+		{"&P+1", true},
+		{strings.Repeat("a", maxHeaderFooterLength), true},
+		{strings.Repeat("a", maxHeaderFooterLength+1), false},
+		{"Trailing &", false},
+		{"&Q", false},
+		{"&\"Arial", false},
+		{"&KZZ0000x", false},
+		{"&Kff", false},
+		{"&é", false},
+	}
+
+	for _, test := range tests {
+		err := validateHeaderFooter(test.text)
+		if (err == nil) != test.valid {
+			t.Errorf("validateHeaderFooter(%q) = %v, want valid %v", test.text, err, test.valid)
+		}
+	}
+}
@@ -103,9 +103,7 @@ func (r *cellResource) Create(ctx context.Context, req resource.CreateRequest, r
 	plan.ID = types.StringValue(cell.ID)
 	plan.Row = types.Int64Value(int64(cell.Row))
 	plan.Column = types.StringValue(cell.Column)
-
-	// type assert value to string
-	plan.Value = types.StringValue("test") // TODO FIX THIS
+	plan.Value = types.StringValue(cellValue(cell.Value))
 
 	// updates last_updated
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
@@ -129,7 +127,7 @@ func (r *cellResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Get refreshed sheet value from client
-	cell, err := r.client.ReadCell(state.WorkbookID.ValueString(), state.SheetID.ValueString(), state.ID.ValueString())
+	cell, err := readCell(r.client, state.WorkbookID.ValueString(), state.SheetID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading cell",
@@ -142,7 +140,7 @@ func (r *cellResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.ID = types.StringValue(cell.ID)
 	state.Row = types.Int64Value(int64(cell.Row))
 	state.Column = types.StringValue(cell.Column)
-	state.Value = types.StringValue("test")
+	state.Value = types.StringValue(cellValue(cell.Value))
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -174,7 +172,7 @@ func (r *cellResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	// Delete existing order
-	err := r.client.DeleteCell(cell)
+	err := deleteCell(r.client, cell)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting cell",
@@ -223,7 +221,7 @@ func (r *cellResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	// Fetch updated items from GetOrder as UpdateOrder items are not
 	// populated.
-	cell, err = r.client.ReadCell(plan.WorkbookID.ValueString(), state.SheetID.ValueString(), state.ID.ValueString())
+	cell, err = readCell(r.client, plan.WorkbookID.ValueString(), state.SheetID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading cell",
@@ -236,7 +234,7 @@ func (r *cellResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	plan.ID = types.StringValue(cell.ID)
 	plan.Row = types.Int64Value(int64(cell.Row))
 	plan.Column = types.StringValue(cell.Column)
-	plan.Value = types.StringValue("test")
+	plan.Value = types.StringValue(cellValue(cell.Value))
 
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -0,0 +1,83 @@
+package terraxcel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Deathfireofdoom/excel-client-go/pkg/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCellValue(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{nil, ""},
+		{"Revenue", "Revenue"},
+		{"1.50", "1.50"},
+		{1.5, "1.5"},
+		{float64(1048576), "1048576"},
+		{true, "true"},
+	}
+
+	for _, test := range tests {
+		if got := cellValue(test.value); got != test.want {
+			t.Errorf("cellValue(%#v) = %q, want %q", test.value, got, test.want)
+		}
+	}
+}
+
+func TestCellResource(t *testing.T) {
+	server, c := newFakeServer(t)
+	endpoint := cellEndpoint("wb-1", "sheet-1", "cell-1")
+	server.handle("POST "+sheetEndpoint("wb-1", "sheet-1")+"/cell", http.StatusCreated,
+		models.Cell{ID: "cell-1", WorkbookID: "wb-1", SheetID: "sheet-1", Row: 1, Column: "A", Value: "Revenue"})
+	server.handle("GET "+endpoint, http.StatusOK,
+		models.Cell{ID: "cell-1", WorkbookID: "wb-1", SheetID: "sheet-1", Row: 1, Column: "A", Value: 1.5})
+	server.handle("DELETE "+endpoint, http.StatusOK, nil)
+
+	r := &cellResource{client: c}
+	s := resourceSchema(t, r)
+	ctx := context.Background()
+
+	plan := cellResourceModel{
+		ID:          types.StringUnknown(),
+		LastUpdated: types.StringUnknown(),
+		WorkbookID:  types.StringValue("wb-1"),
+		SheetID:     types.StringValue("sheet-1"),
+		Row:         types.Int64Unknown(),
+		Column:      types.StringValue("A"),
+		Value:       types.StringValue("Revenue"),
+	}
+	createResp := &resource.CreateResponse{State: newState(t, s, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: newPlan(t, s, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", createResp.Diagnostics)
+	}
+
+	var state cellResourceModel
+	createResp.State.Get(ctx, &state)
+	if state.Value.ValueString() != "Revenue" {
+		t.Errorf("created value = %s, want the planned value", state.Value)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", readResp.Diagnostics)
+	}
+	readResp.State.Get(ctx, &state)
+	if state.Value.ValueString() != "1.5" {
+		t.Errorf("refreshed value = %s, want 1.5", state.Value)
+	}
+
+	deleteResp := &resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() || !server.received("DELETE "+endpoint) {
+		t.Fatalf("delete: %v", deleteResp.Diagnostics)
+	}
+}
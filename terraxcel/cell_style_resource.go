@@ -0,0 +1,272 @@
+package terraxcel
+
+import (
+	"context"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &cellStyleResource{}
+	_ resource.ResourceWithConfigure = &cellStyleResource{}
+)
+
+// NewCellStyleResource is a helper function to simplify the provider implementation.
+func NewCellStyleResource() resource.Resource {
+	return &cellStyleResource{}
+}
+
+type cellStyleResource struct {
+	client *client.Client
+}
+
+type cellStyleResourceModel struct {
+	ID           types.String     `tfsdk:"id"`
+	LastUpdated  types.String     `tfsdk:"last_updated"`
+	WorkbookID   types.String     `tfsdk:"workbook_id"`
+	SheetID      types.String     `tfsdk:"sheet_id"`
+	Range        types.String     `tfsdk:"range"`
+	StyleName    types.String     `tfsdk:"style_name"`
+	Font         *fontModel       `tfsdk:"font"`
+	Fill         *fillModel       `tfsdk:"fill"`
+	Borders      []borderModel    `tfsdk:"borders"`
+	Alignment    *alignmentModel  `tfsdk:"alignment"`
+	NumberFormat types.String     `tfsdk:"number_format"`
+	Protection   *protectionModel `tfsdk:"protection"`
+}
+
+func (m *cellStyleResourceModel) style() styleModel {
+	return styleModel{
+		Font:         m.Font,
+		Fill:         m.Fill,
+		Borders:      m.Borders,
+		Alignment:    m.Alignment,
+		NumberFormat: m.NumberFormat,
+		Protection:   m.Protection,
+	}
+}
+
+func (m *cellStyleResourceModel) setStyle(s styleModel) {
+	m.Font = s.Font
+	m.Fill = s.Fill
+	m.Borders = s.Borders
+	m.Alignment = s.Alignment
+	m.NumberFormat = s.NumberFormat
+	m.Protection = s.Protection
+}
+
+// Metadata returns the resource type name.
+func (r *cellStyleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cell_style"
+}
+
+// Schema defines the schema for the resource.
+func (r *cellStyleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := styleAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["workbook_id"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["sheet_id"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	// a single cell like A1 or a range like A1:C3
+	attributes["range"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			cellReference(true),
+		},
+	}
+	// the named style is applied first, the other attributes on top of it
+	attributes["style_name"] = schema.StringAttribute{
+		Optional: true,
+	}
+	attributes["last_updated"] = schema.StringAttribute{
+		Computed: true,
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (r *cellStyleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan cellStyleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// applies the style to the range with help of the client
+	rangeStyle, err := createCellStyle(r.client, &cellStyle{
+		WorkbookID: plan.WorkbookID.ValueString(),
+		SheetID:    plan.SheetID.ValueString(),
+		Range:      plan.Range.ValueString(),
+		StyleName:  plan.StyleName.ValueStringPointer(),
+		Style:      plan.style().expand(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating cell style",
+			"Could not apply style to range "+plan.Range.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.ID = types.StringValue(rangeStyle.ID)
+	plan.StyleName = types.StringPointerValue(rangeStyle.StyleName)
+	plan.setStyle(flattenStyle(rangeStyle.Style))
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *cellStyleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state cellStyleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed style from client
+	rangeStyle, err := readCellStyle(r.client, &cellStyle{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+		SheetID:    state.SheetID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading cell style",
+			"Could not read cell style with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = types.StringValue(rangeStyle.ID)
+	state.Range = types.StringValue(rangeStyle.Range)
+	state.StyleName = types.StringPointerValue(rangeStyle.StyleName)
+	state.setStyle(flattenStyle(rangeStyle.Style))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resets the style of the range and removes the Terraform state on success.
+func (r *cellStyleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state cellStyleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteCellStyle(r.client, &cellStyle{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+		SheetID:    state.SheetID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting cell style",
+			"Could not delete cell style, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Update applies the new style to the range.
+func (r *cellStyleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state cellStyleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan cellStyleResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rangeStyle, err := updateCellStyle(r.client, &cellStyle{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+		SheetID:    state.SheetID.ValueString(),
+		Range:      plan.Range.ValueString(),
+		StyleName:  plan.StyleName.ValueStringPointer(),
+		Style:      plan.style().expand(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating cell style",
+			"Could not update cell style, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.ID = types.StringValue(rangeStyle.ID)
+	plan.StyleName = types.StringPointerValue(rangeStyle.StyleName)
+	plan.setStyle(flattenStyle(rangeStyle.Style))
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cellStyleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
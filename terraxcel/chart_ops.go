@@ -0,0 +1,76 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// chart is a native Excel chart, its series refer to ranges so the chart
+// updates with the data in the sheets. Sizes are in pixels.
+type chart struct {
+	ID             string        `json:"id"`
+	WorkbookID     string        `json:"workbook_id"`
+	SheetID        string        `json:"sheet_id"`
+	Type           string        `json:"type"`
+	Title          *string       `json:"title,omitempty"`
+	Series         []chartSeries `json:"series"`
+	XAxis          *chartAxis    `json:"x_axis,omitempty"`
+	YAxis          *chartAxis    `json:"y_axis,omitempty"`
+	LegendPosition *string       `json:"legend_position,omitempty"`
+	Anchor         string        `json:"anchor"`
+	Width          *int64        `json:"width,omitempty"`
+	Height         *int64        `json:"height,omitempty"`
+}
+
+// chartSeries plots the values of a range, against the categories or, for
+// scatter charts, against the x values in the categories range.
+type chartSeries struct {
+	Name       *string `json:"name,omitempty"`
+	Values     string  `json:"values"`
+	Categories *string `json:"categories,omitempty"`
+	Color      *string `json:"color,omitempty"`
+}
+
+type chartAxis struct {
+	Title          *string  `json:"title,omitempty"`
+	Min            *float64 `json:"min,omitempty"`
+	Max            *float64 `json:"max,omitempty"`
+	MajorGridlines *bool    `json:"major_gridlines,omitempty"`
+	NumberFormat   *string  `json:"number_format,omitempty"`
+}
+
+func chartEndpoint(sheetChart *chart) string {
+	return sheetEndpoint(sheetChart.WorkbookID, sheetChart.SheetID) + "/chart"
+}
+
+func createChart(c *client.Client, sheetChart *chart) (*chart, error) {
+	created := &chart{}
+	err := doRequest(c, http.MethodPost, chartEndpoint(sheetChart), sheetChart, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readChart(c *client.Client, sheetChart *chart) (*chart, error) {
+	read := &chart{}
+	err := doRequest(c, http.MethodGet, chartEndpoint(sheetChart)+"/"+sheetChart.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+func updateChart(c *client.Client, sheetChart *chart) (*chart, error) {
+	updated := &chart{}
+	err := doRequest(c, http.MethodPut, chartEndpoint(sheetChart)+"/"+sheetChart.ID, sheetChart, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deleteChart(c *client.Client, sheetChart *chart) error {
+	return doRequest(c, http.MethodDelete, chartEndpoint(sheetChart)+"/"+sheetChart.ID, nil, nil, http.StatusOK)
+}
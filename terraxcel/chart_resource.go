@@ -0,0 +1,458 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &chartResource{}
+	_ resource.ResourceWithConfigure      = &chartResource{}
+	_ resource.ResourceWithValidateConfig = &chartResource{}
+)
+
+// NewChartResource is a helper function to simplify the provider implementation.
+func NewChartResource() resource.Resource {
+	return &chartResource{}
+}
+
+type chartResource struct {
+	client *client.Client
+}
+
+type chartResourceModel struct {
+	ID             types.String       `tfsdk:"id"`
+	LastUpdated    types.String       `tfsdk:"last_updated"`
+	WorkbookID     types.String       `tfsdk:"workbook_id"`
+	SheetID        types.String       `tfsdk:"sheet_id"`
+	Type           types.String       `tfsdk:"type"`
+	Title          types.String       `tfsdk:"title"`
+	Series         []chartSeriesModel `tfsdk:"series"`
+	XAxis          *chartAxisModel    `tfsdk:"x_axis"`
+	YAxis          *chartAxisModel    `tfsdk:"y_axis"`
+	LegendPosition types.String       `tfsdk:"legend_position"`
+	Anchor         types.String       `tfsdk:"anchor"`
+	Width          types.Int64        `tfsdk:"width"`
+	Height         types.Int64        `tfsdk:"height"`
+}
+
+type chartSeriesModel struct {
+	Name       types.String `tfsdk:"name"`
+	Values     types.String `tfsdk:"values"`
+	Categories types.String `tfsdk:"categories"`
+	Color      types.String `tfsdk:"color"`
+}
+
+type chartAxisModel struct {
+	Title          types.String  `tfsdk:"title"`
+	Min            types.Float64 `tfsdk:"min"`
+	Max            types.Float64 `tfsdk:"max"`
+	MajorGridlines types.Bool    `tfsdk:"major_gridlines"`
+	NumberFormat   types.String  `tfsdk:"number_format"`
+}
+
+func (m *chartResourceModel) expand() *chart {
+	sheetChart := &chart{
+		ID:             m.ID.ValueString(),
+		WorkbookID:     m.WorkbookID.ValueString(),
+		SheetID:        m.SheetID.ValueString(),
+		Type:           m.Type.ValueString(),
+		Title:          m.Title.ValueStringPointer(),
+		XAxis:          m.XAxis.expand(),
+		YAxis:          m.YAxis.expand(),
+		LegendPosition: m.LegendPosition.ValueStringPointer(),
+		Anchor:         m.Anchor.ValueString(),
+		Width:          m.Width.ValueInt64Pointer(),
+		Height:         m.Height.ValueInt64Pointer(),
+	}
+
+	for _, series := range m.Series {
+		sheetChart.Series = append(sheetChart.Series, chartSeries{
+			Name:       series.Name.ValueStringPointer(),
+			Values:     series.Values.ValueString(),
+			Categories: series.Categories.ValueStringPointer(),
+			Color:      series.Color.ValueStringPointer(),
+		})
+	}
+
+	return sheetChart
+}
+
+func (m *chartResourceModel) flatten(sheetChart *chart) {
+	m.ID = types.StringValue(sheetChart.ID)
+	m.Type = types.StringValue(sheetChart.Type)
+	m.Title = types.StringPointerValue(sheetChart.Title)
+	m.XAxis = flattenChartAxis(sheetChart.XAxis)
+	m.YAxis = flattenChartAxis(sheetChart.YAxis)
+	m.LegendPosition = types.StringPointerValue(sheetChart.LegendPosition)
+	m.Anchor = types.StringValue(sheetChart.Anchor)
+	m.Width = types.Int64PointerValue(sheetChart.Width)
+	m.Height = types.Int64PointerValue(sheetChart.Height)
+
+	m.Series = nil
+	for _, series := range sheetChart.Series {
+		m.Series = append(m.Series, chartSeriesModel{
+			Name:       types.StringPointerValue(series.Name),
+			Values:     types.StringValue(series.Values),
+			Categories: types.StringPointerValue(series.Categories),
+			Color:      types.StringPointerValue(series.Color),
+		})
+	}
+}
+
+func (m *chartAxisModel) expand() *chartAxis {
+	if m == nil {
+		return nil
+	}
+
+	return &chartAxis{
+		Title:          m.Title.ValueStringPointer(),
+		Min:            m.Min.ValueFloat64Pointer(),
+		Max:            m.Max.ValueFloat64Pointer(),
+		MajorGridlines: m.MajorGridlines.ValueBoolPointer(),
+		NumberFormat:   m.NumberFormat.ValueStringPointer(),
+	}
+}
+
+func flattenChartAxis(axis *chartAxis) *chartAxisModel {
+	if axis == nil {
+		return nil
+	}
+
+	return &chartAxisModel{
+		Title:          types.StringPointerValue(axis.Title),
+		Min:            types.Float64PointerValue(axis.Min),
+		Max:            types.Float64PointerValue(axis.Max),
+		MajorGridlines: types.BoolPointerValue(axis.MajorGridlines),
+		NumberFormat:   types.StringPointerValue(axis.NumberFormat),
+	}
+}
+
+// chartAxisAttribute returns the schema of an axis, the x and y axis share it.
+func chartAxisAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				Optional: true,
+			},
+			// bounds of a value axis, scaled automatically without them
+			"min": schema.Float64Attribute{
+				Optional: true,
+			},
+			"max": schema.Float64Attribute{
+				Optional: true,
+			},
+			"major_gridlines": schema.BoolAttribute{
+				Optional: true,
+			},
+			"number_format": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *chartResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chart"
+}
+
+// Schema defines the schema for the resource.
+func (r *chartResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringOneOf("bar", "column", "line", "pie", "scatter", "area"),
+				},
+			},
+			"title": schema.StringAttribute{
+				Optional: true,
+			},
+			"series": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional: true,
+						},
+						// range with the values, e.g. Summary!$B$2:$B$13
+						"values": schema.StringAttribute{
+							Required: true,
+						},
+						// range with the categories, or the x values of a
+						// scatter chart
+						"categories": schema.StringAttribute{
+							Optional: true,
+						},
+						"color": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								hexColor(),
+							},
+						},
+					},
+				},
+			},
+			"x_axis": chartAxisAttribute(),
+			"y_axis": chartAxisAttribute(),
+			"legend_position": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("right"),
+				Validators: []validator.String{
+					stringOneOf("right", "left", "top", "bottom", "none"),
+				},
+			},
+			// the cell the top left corner of the chart is placed in
+			"anchor": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					cellReference(false),
+				},
+			},
+			"width": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(480),
+				Validators: []validator.Int64{
+					int64Between(50, 10000),
+				},
+			},
+			"height": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(288),
+				Validators: []validator.Int64{
+					int64Between(50, 10000),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure the series refer to ranges, and fit the type of
+// the chart.
+func (r *chartResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config chartResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invalid := func(attribute path.Path, message string) {
+		resp.Diagnostics.AddAttributeError(attribute, "Invalid chart", message)
+	}
+
+	// checks that a reference refers to cells in a sheet or a defined name
+	checkReference := func(attribute path.Path, reference types.String) {
+		if reference.IsNull() || reference.IsUnknown() {
+			return
+		}
+		if err := validateSheetReference(reference.ValueString()); err != nil {
+			invalid(attribute, fmt.Sprintf("series must refer to a range like Summary!$B$2:$B$13 or a defined name: %s", err))
+		}
+	}
+
+	if len(config.Series) == 0 {
+		invalid(path.Root("series"), "a chart requires at least one series")
+	}
+
+	chartType := config.Type.ValueString()
+	for i, series := range config.Series {
+		seriesPath := path.Root("series").AtListIndex(i)
+		checkReference(seriesPath.AtName("values"), series.Values)
+		checkReference(seriesPath.AtName("categories"), series.Categories)
+
+		if chartType == "scatter" && series.Categories.IsNull() {
+			invalid(seriesPath.AtName("categories"), "series of scatter charts require categories with the x values")
+		}
+	}
+
+	if chartType == "pie" {
+		if len(config.Series) > 1 {
+			invalid(path.Root("series"), "pie charts have exactly one series")
+		}
+		if config.XAxis != nil || config.YAxis != nil {
+			invalid(path.Root("x_axis"), "pie charts have no axes")
+		}
+	}
+
+	for name, axis := range map[string]*chartAxisModel{"x_axis": config.XAxis, "y_axis": config.YAxis} {
+		if axis == nil || axis.Min.IsNull() || axis.Max.IsNull() || axis.Min.IsUnknown() || axis.Max.IsUnknown() {
+			continue
+		}
+		if axis.Min.ValueFloat64() >= axis.Max.ValueFloat64() {
+			invalid(path.Root(name).AtName("max"), "max must be greater than min")
+		}
+	}
+}
+
+func (r *chartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan chartResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// creates the chart with help of the client
+	sheetChart, err := createChart(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating chart",
+			"Could not create chart at "+plan.Anchor.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(sheetChart)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *chartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state chartResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed chart from client
+	sheetChart, err := readChart(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading chart",
+			"Could not read chart with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(sheetChart)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *chartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state chartResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteChart(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting chart",
+			"Could not delete chart, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the chart
+func (r *chartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state chartResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan chartResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	sheetChart, err := updateChart(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating chart",
+			"Could not update chart, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(sheetChart)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *chartResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
@@ -0,0 +1,137 @@
+package terraxcel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestChartValidateConfig(t *testing.T) {
+	series := func(values string) chartSeriesModel {
+		return chartSeriesModel{Values: types.StringValue(values)}
+	}
+	scatterSeries := chartSeriesModel{
+		Values:     types.StringValue("Summary!$C$2:$C$13"),
+		Categories: types.StringValue("Summary!$B$2:$B$13"),
+	}
+	axis := func(min, max float64) *chartAxisModel {
+		return &chartAxisModel{Min: types.Float64Value(min), Max: types.Float64Value(max)}
+	}
+
+	tests := []struct {
+		name      string
+		chartType string
+		series    []chartSeriesModel
+		xAxis     *chartAxisModel
+		yAxis     *chartAxisModel
+		valid     bool
+	}{
+		{
+			name:      "column chart",
+			chartType: "column",
+			series:    []chartSeriesModel{series("Summary!$B$2:$B$13"), series("'Sales 2024'!$C$2:$C$13")},
+			yAxis:     axis(0, 100),
+			valid:     true,
+		},
+		{
+			name:      "defined name",
+			chartType: "line",
+			series:    []chartSeriesModel{series("Revenue")},
+			valid:     true,
+		},
+		{
+			name:      "unknown values",
+			chartType: "line",
+			series:    []chartSeriesModel{{Values: types.StringUnknown()}},
+			valid:     true,
+		},
+		{
+			name:      "no series",
+			chartType: "column",
+		},
+		{
+			name:      "range without a sheet",
+			chartType: "column",
+			series:    []chartSeriesModel{series("B2:B13")},
+		},
+		{
+			name:      "categories without a sheet",
+			chartType: "column",
+			series: []chartSeriesModel{
+				{Values: types.StringValue("Summary!$B$2:$B$13"), Categories: types.StringValue("A2:A13")},
+			},
+		},
+		{
+			name:      "scatter chart",
+			chartType: "scatter",
+			series:    []chartSeriesModel{scatterSeries},
+			valid:     true,
+		},
+		{
+			name:      "scatter series without categories",
+			chartType: "scatter",
+			series:    []chartSeriesModel{series("Summary!$C$2:$C$13")},
+		},
+		{
+			name:      "pie chart",
+			chartType: "pie",
+			series:    []chartSeriesModel{series("Summary!$B$2:$B$13")},
+			valid:     true,
+		},
+		{
+			name:      "pie chart with two series",
+			chartType: "pie",
+			series:    []chartSeriesModel{series("Summary!$B$2:$B$13"), series("Summary!$C$2:$C$13")},
+		},
+		{
+			name:      "pie chart with an axis",
+			chartType: "pie",
+			series:    []chartSeriesModel{series("Summary!$B$2:$B$13")},
+			yAxis:     &chartAxisModel{},
+		},
+		{
+			name:      "min equal to max",
+			chartType: "bar",
+			series:    []chartSeriesModel{series("Summary!$B$2:$B$13")},
+			xAxis:     axis(10, 10),
+		},
+		{
+			name:      "min greater than max",
+			chartType: "line",
+			series:    []chartSeriesModel{series("Summary!$B$2:$B$13")},
+			yAxis:     axis(100, 0),
+		},
+		{
+			name:      "only min",
+			chartType: "line",
+			series:    []chartSeriesModel{series("Summary!$B$2:$B$13")},
+			yAxis:     &chartAxisModel{Min: types.Float64Value(100)},
+			valid:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &chartResource{}
+			s := resourceSchema(t, r)
+			config := chartResourceModel{
+				WorkbookID: types.StringValue("wb-1"),
+				SheetID:    types.StringValue("sheet-1"),
+				Type:       types.StringValue(test.chartType),
+				Series:     test.series,
+				XAxis:      test.xAxis,
+				YAxis:      test.yAxis,
+				Anchor:     types.StringValue("H2"),
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newConfig(t, s, config)}, resp)
+
+			if valid := !resp.Diagnostics.HasError(); valid != test.valid {
+				t.Errorf("valid = %v, want %v: %v", valid, test.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
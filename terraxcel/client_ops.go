@@ -0,0 +1,76 @@
+package terraxcel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// doRequest sends a request to the TerraXcel server for endpoints not covered
+// by the client, and decodes the response into out if out is not nil.
+//
+// The request is built here instead of with client.NewRequest, as that one
+// panics when called without a body. Client methods sending GET and DELETE
+// requests, like ReadWorkbook and DeleteSheet, are replaced by helpers using
+// doRequest for that reason.
+func doRequest(c *client.Client, method, endpoint string, body, out interface{}, expectedStatus int) error {
+	var reader io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshalling request body: %w", err)
+		}
+		reader = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := http.NewRequest(method, c.BaseURL+endpoint, reader)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.AuthToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectedStatus {
+		return fmt.Errorf("received non-%d status code: %d", expectedStatus, resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(out)
+	if err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return nil
+}
+
+func workbookEndpoint(workbookID string) string {
+	return "/workbook/" + workbookID
+}
+
+func sheetEndpoint(workbookID, sheetID string) string {
+	return workbookEndpoint(workbookID) + "/sheet/" + sheetID
+}
+
+// readExtensions replaces client.ReadExtensions, which panics as it sends its
+// request without a body.
+func readExtensions(c *client.Client) ([]string, error) {
+	var extensions []string
+	err := doRequest(c, http.MethodGet, "/extension", nil, &extensions, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return extensions, nil
+}
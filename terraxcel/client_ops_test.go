@@ -0,0 +1,160 @@
+package terraxcel
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeServer is a TerraXcel server answering the routes registered with
+// handle, e.g. "GET /workbook/wb-1". It records every request it receives,
+// requests to other routes are answered with 404.
+type fakeServer struct {
+	mu       sync.Mutex
+	routes   map[string]func(body []byte) (int, interface{})
+	requests []string
+	bodies   map[string][]byte
+}
+
+func newFakeServer(t *testing.T) (*fakeServer, *client.Client) {
+	t.Helper()
+
+	server := &fakeServer{
+		routes: map[string]func(body []byte) (int, interface{}){},
+		bodies: map[string][]byte{},
+	}
+	httpServer := httptest.NewServer(http.HandlerFunc(server.serve))
+	t.Cleanup(httpServer.Close)
+
+	c, err := client.NewClient(&client.ClientConfig{BaseURL: httpServer.URL, AuthToken: "test"})
+	if err != nil {
+		t.Fatalf("could not create client: %s", err)
+	}
+	return server, c
+}
+
+// handle answers the route with the status and the response encoded as json.
+func (s *fakeServer) handle(route string, status int, response interface{}) {
+	s.handleFunc(route, func([]byte) (int, interface{}) {
+		return status, response
+	})
+}
+
+func (s *fakeServer) handleFunc(route string, handler func(body []byte) (int, interface{})) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[route] = handler
+}
+
+func (s *fakeServer) serve(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	route := req.Method + " " + req.URL.Path
+
+	s.mu.Lock()
+	s.requests = append(s.requests, route)
+	s.bodies[route] = body
+	handler, ok := s.routes[route]
+	s.mu.Unlock()
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	status, response := handler(body)
+	w.WriteHeader(status)
+	if response != nil {
+		_ = json.NewEncoder(w).Encode(response)
+	}
+}
+
+// received reports whether the server received a request for the route.
+func (s *fakeServer) received(route string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, request := range s.requests {
+		if request == route {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeBody decodes the last body sent to the route into out.
+func (s *fakeServer) decodeBody(t *testing.T, route string, out interface{}) {
+	t.Helper()
+
+	s.mu.Lock()
+	body := s.bodies[route]
+	s.mu.Unlock()
+
+	if err := json.Unmarshal(body, out); err != nil {
+		t.Fatalf("could not decode body of %s: %s", route, err)
+	}
+}
+
+func resourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("invalid schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// newState returns a state of the schema, set to model or null if model is nil.
+func newState(t *testing.T, s schema.Schema, model interface{}) tfsdk.State {
+	t.Helper()
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	if model != nil {
+		if diags := state.Set(context.Background(), model); diags.HasError() {
+			t.Fatalf("could not set state: %v", diags)
+		}
+	}
+	return state
+}
+
+// newPlan returns a plan of the schema, set to model or null if model is nil.
+func newPlan(t *testing.T, s schema.Schema, model interface{}) tfsdk.Plan {
+	t.Helper()
+
+	state := newState(t, s, model)
+	return tfsdk.Plan{Schema: s, Raw: state.Raw}
+}
+
+// newConfig returns a config of the schema set to model.
+func newConfig(t *testing.T, s schema.Schema, model interface{}) tfsdk.Config {
+	t.Helper()
+
+	state := newState(t, s, model)
+	return tfsdk.Config{Schema: s, Raw: state.Raw}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+// hasErrorSummary reports whether one of the errors has the summary.
+func hasErrorSummary(errors diag.Diagnostics, summary string) bool {
+	for _, err := range errors {
+		if err.Summary() == summary {
+			return true
+		}
+	}
+	return false
+}
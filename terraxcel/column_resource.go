@@ -0,0 +1,283 @@
+package terraxcel
+
+import (
+	"context"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &columnResource{}
+	_ resource.ResourceWithConfigure      = &columnResource{}
+	_ resource.ResourceWithValidateConfig = &columnResource{}
+)
+
+// NewColumnResource is a helper function to simplify the provider implementation.
+func NewColumnResource() resource.Resource {
+	return &columnResource{}
+}
+
+type columnResource struct {
+	client *client.Client
+}
+
+type columnResourceModel struct {
+	ID           types.String  `tfsdk:"id"`
+	LastUpdated  types.String  `tfsdk:"last_updated"`
+	WorkbookID   types.String  `tfsdk:"workbook_id"`
+	SheetID      types.String  `tfsdk:"sheet_id"`
+	Columns      types.String  `tfsdk:"columns"`
+	Width        types.Float64 `tfsdk:"width"`
+	AutoFit      types.Bool    `tfsdk:"auto_fit"`
+	Hidden       types.Bool    `tfsdk:"hidden"`
+	OutlineLevel types.Int64   `tfsdk:"outline_level"`
+}
+
+func (m *columnResourceModel) expand() *column {
+	return &column{
+		ID:           m.ID.ValueString(),
+		WorkbookID:   m.WorkbookID.ValueString(),
+		SheetID:      m.SheetID.ValueString(),
+		Columns:      m.Columns.ValueString(),
+		Width:        m.Width.ValueFloat64Pointer(),
+		AutoFit:      m.AutoFit.ValueBoolPointer(),
+		Hidden:       m.Hidden.ValueBoolPointer(),
+		OutlineLevel: m.OutlineLevel.ValueInt64Pointer(),
+	}
+}
+
+func (m *columnResourceModel) flatten(sheetColumn *column) {
+	m.ID = types.StringValue(sheetColumn.ID)
+	m.Columns = types.StringValue(sheetColumn.Columns)
+	m.AutoFit = types.BoolPointerValue(sheetColumn.AutoFit)
+	m.Hidden = types.BoolPointerValue(sheetColumn.Hidden)
+	m.OutlineLevel = types.Int64PointerValue(sheetColumn.OutlineLevel)
+
+	// the width of auto fitted columns depends on their content
+	if !m.AutoFit.ValueBool() {
+		m.Width = types.Float64PointerValue(sheetColumn.Width)
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *columnResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_column"
+}
+
+// Schema defines the schema for the resource.
+func (r *columnResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// a column like B or columns like B:D
+			"columns": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					columnSpan(),
+				},
+			},
+			// width in characters, as in Excel
+			"width": schema.Float64Attribute{
+				Optional: true,
+				Validators: []validator.Float64{
+					float64Between(0, 255),
+				},
+			},
+			"auto_fit": schema.BoolAttribute{
+				Optional: true,
+			},
+			"hidden": schema.BoolAttribute{
+				Optional: true,
+			},
+			// level of the group the columns are in, 0 is not grouped
+			"outline_level": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64Between(0, 7),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure the width is not both set and auto fitted.
+func (r *columnResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config columnResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Width.IsNull() && config.AutoFit.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("width"),
+			"Conflicting width",
+			"width can not be set for auto fitted columns",
+		)
+	}
+}
+
+func (r *columnResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan columnResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// sets the columns with help of the client
+	sheetColumn, err := createColumn(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating column",
+			"Could not set columns "+plan.Columns.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(sheetColumn)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *columnResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state columnResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed columns from client
+	sheetColumn, err := readColumn(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading column",
+			"Could not read column with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(sheetColumn)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resets the columns and removes the Terraform state on success.
+func (r *columnResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state columnResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteColumn(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting column",
+			"Could not reset columns, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the columns
+func (r *columnResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state columnResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan columnResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	sheetColumn, err := updateColumn(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating column",
+			"Could not update columns, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(sheetColumn)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *columnResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
@@ -0,0 +1,86 @@
+package terraxcel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestColumnValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		width   types.Float64
+		autoFit types.Bool
+		valid   bool
+	}{
+		{"width", types.Float64Value(18), types.BoolNull(), true},
+		{"auto fit", types.Float64Null(), types.BoolValue(true), true},
+		{"width without auto fit", types.Float64Value(18), types.BoolValue(false), true},
+		{"width and auto fit", types.Float64Value(18), types.BoolValue(true), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &columnResource{}
+			s := resourceSchema(t, r)
+			config := columnResourceModel{
+				WorkbookID: types.StringValue("wb-1"),
+				SheetID:    types.StringValue("sheet-1"),
+				Columns:    types.StringValue("B:D"),
+				Width:      test.width,
+				AutoFit:    test.autoFit,
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newConfig(t, s, config)}, resp)
+
+			if valid := !resp.Diagnostics.HasError(); valid != test.valid {
+				t.Errorf("valid = %v, want %v: %v", valid, test.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestColumnCreateAutoFit(t *testing.T) {
+	server, c := newFakeServer(t)
+	// the server reports the width the columns were fitted to
+	server.handle("POST "+sheetEndpoint("wb-1", "sheet-1")+"/column", http.StatusCreated, column{
+		ID:      "col-1",
+		Columns: "B:D",
+		Width:   ptr(23.5),
+		AutoFit: ptr(true),
+	})
+
+	r := &columnResource{client: c}
+	s := resourceSchema(t, r)
+	plan := columnResourceModel{
+		ID:          types.StringUnknown(),
+		LastUpdated: types.StringUnknown(),
+		WorkbookID:  types.StringValue("wb-1"),
+		SheetID:     types.StringValue("sheet-1"),
+		Columns:     types.StringValue("B:D"),
+		AutoFit:     types.BoolValue(true),
+	}
+
+	resp := &resource.CreateResponse{State: newState(t, s, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, plan)}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var sent column
+	server.decodeBody(t, "POST "+sheetEndpoint("wb-1", "sheet-1")+"/column", &sent)
+	if sent.Width != nil {
+		t.Errorf("sent width %g for auto fitted columns", *sent.Width)
+	}
+
+	var state columnResourceModel
+	resp.State.Get(context.Background(), &state)
+	if !state.Width.IsNull() {
+		t.Errorf("width = %s, the width of auto fitted columns must not be kept as it is not configured", state.Width)
+	}
+}
@@ -0,0 +1,54 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// comment is a note attached to a cell, shown when hovering over the cell or
+// always when visible is set.
+type comment struct {
+	ID         string  `json:"id"`
+	WorkbookID string  `json:"workbook_id"`
+	SheetID    string  `json:"sheet_id"`
+	Cell       string  `json:"cell"`
+	Author     *string `json:"author,omitempty"`
+	Text       string  `json:"text"`
+	Visible    *bool   `json:"visible,omitempty"`
+}
+
+func commentEndpoint(cellComment *comment) string {
+	return sheetEndpoint(cellComment.WorkbookID, cellComment.SheetID) + "/comment"
+}
+
+func createComment(c *client.Client, cellComment *comment) (*comment, error) {
+	created := &comment{}
+	err := doRequest(c, http.MethodPost, commentEndpoint(cellComment), cellComment, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readComment(c *client.Client, cellComment *comment) (*comment, error) {
+	read := &comment{}
+	err := doRequest(c, http.MethodGet, commentEndpoint(cellComment)+"/"+cellComment.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+func updateComment(c *client.Client, cellComment *comment) (*comment, error) {
+	updated := &comment{}
+	err := doRequest(c, http.MethodPut, commentEndpoint(cellComment)+"/"+cellComment.ID, cellComment, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deleteComment(c *client.Client, cellComment *comment) error {
+	return doRequest(c, http.MethodDelete, commentEndpoint(cellComment)+"/"+cellComment.ID, nil, nil, http.StatusOK)
+}
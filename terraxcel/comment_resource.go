@@ -0,0 +1,246 @@
+package terraxcel
+
+import (
+	"context"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &commentResource{}
+	_ resource.ResourceWithConfigure = &commentResource{}
+)
+
+// NewCommentResource is a helper function to simplify the provider implementation.
+func NewCommentResource() resource.Resource {
+	return &commentResource{}
+}
+
+type commentResource struct {
+	client *client.Client
+}
+
+type commentResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	WorkbookID  types.String `tfsdk:"workbook_id"`
+	SheetID     types.String `tfsdk:"sheet_id"`
+	Cell        types.String `tfsdk:"cell"`
+	Author      types.String `tfsdk:"author"`
+	Text        types.String `tfsdk:"text"`
+	Visible     types.Bool   `tfsdk:"visible"`
+}
+
+func (m *commentResourceModel) expand() *comment {
+	return &comment{
+		ID:         m.ID.ValueString(),
+		WorkbookID: m.WorkbookID.ValueString(),
+		SheetID:    m.SheetID.ValueString(),
+		Cell:       m.Cell.ValueString(),
+		Author:     m.Author.ValueStringPointer(),
+		Text:       m.Text.ValueString(),
+		Visible:    m.Visible.ValueBoolPointer(),
+	}
+}
+
+func (m *commentResourceModel) flatten(cellComment *comment) {
+	m.ID = types.StringValue(cellComment.ID)
+	m.Cell = types.StringValue(cellComment.Cell)
+	m.Author = types.StringPointerValue(cellComment.Author)
+	m.Text = types.StringValue(cellComment.Text)
+	m.Visible = types.BoolPointerValue(cellComment.Visible)
+}
+
+// Metadata returns the resource type name.
+func (r *commentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_comment"
+}
+
+// Schema defines the schema for the resource.
+func (r *commentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// a cell has at most one comment
+			"cell": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					cellReference(false),
+				},
+			},
+			"author": schema.StringAttribute{
+				Optional: true,
+			},
+			"text": schema.StringAttribute{
+				Required: true,
+			},
+			// shows the comment without hovering over the cell
+			"visible": schema.BoolAttribute{
+				Optional: true,
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *commentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan commentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// creates the comment with help of the client
+	cellComment, err := createComment(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating comment",
+			"Could not create comment on cell "+plan.Cell.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(cellComment)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *commentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state commentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed comment from client
+	cellComment, err := readComment(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading comment",
+			"Could not read comment with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(cellComment)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *commentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state commentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteComment(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting comment",
+			"Could not delete comment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the comment
+func (r *commentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state commentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan commentResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	cellComment, err := updateComment(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating comment",
+			"Could not update comment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(cellComment)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *commentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
@@ -0,0 +1,84 @@
+package terraxcel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCommentRoundTrip(t *testing.T) {
+	server, c := newFakeServer(t)
+	endpoint := sheetEndpoint("wb-1", "sheet-1") + "/comment"
+
+	// the server stores the comment as it is sent
+	var stored comment
+	store := func(status int) func([]byte) (int, interface{}) {
+		return func(body []byte) (int, interface{}) {
+			stored = comment{}
+			if err := json.Unmarshal(body, &stored); err != nil {
+				return http.StatusBadRequest, nil
+			}
+			stored.ID = "comment-1"
+			return status, stored
+		}
+	}
+	server.handleFunc("POST "+endpoint, store(http.StatusCreated))
+	server.handleFunc("PUT "+endpoint+"/comment-1", store(http.StatusOK))
+	server.handleFunc("GET "+endpoint+"/comment-1", func([]byte) (int, interface{}) {
+		return http.StatusOK, stored
+	})
+
+	r := &commentResource{client: c}
+	s := resourceSchema(t, r)
+	plan := commentResourceModel{
+		ID:          types.StringUnknown(),
+		LastUpdated: types.StringUnknown(),
+		WorkbookID:  types.StringValue("wb-1"),
+		SheetID:     types.StringValue("sheet-1"),
+		Cell:        types.StringValue("E12"),
+		Author:      types.StringValue("Finance"),
+		Text:        types.StringValue("Includes the one-off restructuring cost"),
+		Visible:     types.BoolNull(),
+	}
+
+	createResp := &resource.CreateResponse{State: newState(t, s, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating the comment: %v", createResp.Diagnostics)
+	}
+	if stored.Cell != "E12" || stored.Author == nil || *stored.Author != "Finance" || stored.Visible != nil {
+		t.Errorf("sent %+v, want the comment of E12 by Finance", stored)
+	}
+
+	// the text and visibility are changed in place
+	var state commentResourceModel
+	createResp.State.Get(context.Background(), &state)
+	plan = state
+	plan.Text = types.StringValue("Restated after the audit")
+	plan.Visible = types.BoolValue(true)
+
+	updateResp := &resource.UpdateResponse{State: createResp.State}
+	r.Update(context.Background(), resource.UpdateRequest{State: createResp.State, Plan: newPlan(t, s, plan)}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error updating the comment: %v", updateResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(context.Background(), resource.ReadRequest{State: updateResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading the comment: %v", readResp.Diagnostics)
+	}
+
+	readResp.State.Get(context.Background(), &state)
+	if !state.ID.Equal(types.StringValue("comment-1")) {
+		t.Errorf("id = %s, want the comment to be updated in place", state.ID)
+	}
+	if !state.Text.Equal(plan.Text) || !state.Visible.Equal(plan.Visible) || !state.Author.Equal(plan.Author) {
+		t.Errorf("state after refresh = %s by %s, visible %s, want %s by %s, visible %s",
+			state.Text, state.Author, state.Visible, plan.Text, plan.Author, plan.Visible)
+	}
+}
@@ -0,0 +1,90 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// conditionalFormat is a set of rules formatting a range of cells based on
+// their values.
+type conditionalFormat struct {
+	ID         string                  `json:"id"`
+	WorkbookID string                  `json:"workbook_id"`
+	SheetID    string                  `json:"sheet_id"`
+	Range      string                  `json:"range"`
+	Rules      []conditionalFormatRule `json:"rules"`
+}
+
+type conditionalFormatRule struct {
+	Type       string                   `json:"type"`
+	Priority   *int64                   `json:"priority,omitempty"`
+	StopIfTrue *bool                    `json:"stop_if_true,omitempty"`
+	Operator   *string                  `json:"operator,omitempty"`
+	Values     []string                 `json:"values,omitempty"`
+	Formula    *string                  `json:"formula,omitempty"`
+	Format     *conditionalFormatFormat `json:"format,omitempty"`
+	ColorScale *colorScale              `json:"color_scale,omitempty"`
+	DataBar    *dataBar                 `json:"data_bar,omitempty"`
+	IconSet    *iconSet                 `json:"icon_set,omitempty"`
+}
+
+// conditionalFormatFormat is the format applied to the cells matching a
+// cell_value or expression rule.
+type conditionalFormatFormat struct {
+	FontColor    *string `json:"font_color,omitempty"`
+	Bold         *bool   `json:"bold,omitempty"`
+	Italic       *bool   `json:"italic,omitempty"`
+	FillColor    *string `json:"fill_color,omitempty"`
+	NumberFormat *string `json:"number_format,omitempty"`
+}
+
+type colorScale struct {
+	MinColor string  `json:"min_color"`
+	MidColor *string `json:"mid_color,omitempty"`
+	MaxColor string  `json:"max_color"`
+}
+
+type dataBar struct {
+	Color string `json:"color"`
+}
+
+type iconSet struct {
+	Style   string `json:"style"`
+	Reverse *bool  `json:"reverse,omitempty"`
+}
+
+func conditionalFormatEndpoint(format *conditionalFormat) string {
+	return sheetEndpoint(format.WorkbookID, format.SheetID) + "/conditional-format"
+}
+
+func createConditionalFormat(c *client.Client, format *conditionalFormat) (*conditionalFormat, error) {
+	created := &conditionalFormat{}
+	err := doRequest(c, http.MethodPost, conditionalFormatEndpoint(format), format, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readConditionalFormat(c *client.Client, format *conditionalFormat) (*conditionalFormat, error) {
+	read := &conditionalFormat{}
+	err := doRequest(c, http.MethodGet, conditionalFormatEndpoint(format)+"/"+format.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+func updateConditionalFormat(c *client.Client, format *conditionalFormat) (*conditionalFormat, error) {
+	updated := &conditionalFormat{}
+	err := doRequest(c, http.MethodPut, conditionalFormatEndpoint(format)+"/"+format.ID, format, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deleteConditionalFormat(c *client.Client, format *conditionalFormat) error {
+	return doRequest(c, http.MethodDelete, conditionalFormatEndpoint(format)+"/"+format.ID, nil, nil, http.StatusOK)
+}
@@ -0,0 +1,549 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &conditionalFormatResource{}
+	_ resource.ResourceWithConfigure      = &conditionalFormatResource{}
+	_ resource.ResourceWithValidateConfig = &conditionalFormatResource{}
+)
+
+// NewConditionalFormatResource is a helper function to simplify the provider implementation.
+func NewConditionalFormatResource() resource.Resource {
+	return &conditionalFormatResource{}
+}
+
+type conditionalFormatResource struct {
+	client *client.Client
+}
+
+type conditionalFormatResourceModel struct {
+	ID          types.String                 `tfsdk:"id"`
+	LastUpdated types.String                 `tfsdk:"last_updated"`
+	WorkbookID  types.String                 `tfsdk:"workbook_id"`
+	SheetID     types.String                 `tfsdk:"sheet_id"`
+	Range       types.String                 `tfsdk:"range"`
+	Rules       []conditionalFormatRuleModel `tfsdk:"rules"`
+}
+
+type conditionalFormatRuleModel struct {
+	Type       types.String            `tfsdk:"type"`
+	Priority   types.Int64             `tfsdk:"priority"`
+	StopIfTrue types.Bool              `tfsdk:"stop_if_true"`
+	Operator   types.String            `tfsdk:"operator"`
+	Values     []types.String          `tfsdk:"values"`
+	Formula    types.String            `tfsdk:"formula"`
+	Format     *conditionalFormatModel `tfsdk:"format"`
+	ColorScale *colorScaleModel        `tfsdk:"color_scale"`
+	DataBar    *dataBarModel           `tfsdk:"data_bar"`
+	IconSet    *iconSetModel           `tfsdk:"icon_set"`
+}
+
+type conditionalFormatModel struct {
+	FontColor    types.String `tfsdk:"font_color"`
+	Bold         types.Bool   `tfsdk:"bold"`
+	Italic       types.Bool   `tfsdk:"italic"`
+	FillColor    types.String `tfsdk:"fill_color"`
+	NumberFormat types.String `tfsdk:"number_format"`
+}
+
+type colorScaleModel struct {
+	MinColor types.String `tfsdk:"min_color"`
+	MidColor types.String `tfsdk:"mid_color"`
+	MaxColor types.String `tfsdk:"max_color"`
+}
+
+type dataBarModel struct {
+	Color types.String `tfsdk:"color"`
+}
+
+type iconSetModel struct {
+	Style   types.String `tfsdk:"style"`
+	Reverse types.Bool   `tfsdk:"reverse"`
+}
+
+// Metadata returns the resource type name.
+func (r *conditionalFormatResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conditional_format"
+}
+
+// Schema defines the schema for the resource.
+func (r *conditionalFormatResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"range": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					cellReference(true),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringOneOf("cell_value", "expression", "color_scale", "data_bar", "icon_set"),
+							},
+						},
+						// rules with a lower priority are evaluated first, the
+						// server assigns one when it is not set
+						"priority": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Int64{
+								int64Between(1, 65535),
+							},
+						},
+						"stop_if_true": schema.BoolAttribute{
+							Optional: true,
+						},
+						// cell_value rules compare the cells to the values
+						"operator": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringOneOf("between", "not_between", "equal", "not_equal", "greater_than",
+									"less_than", "greater_than_or_equal", "less_than_or_equal"),
+							},
+						},
+						"values": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						// expression rules format the cells the formula is true for
+						"formula": schema.StringAttribute{
+							Optional: true,
+						},
+						"format": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"font_color": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										hexColor(),
+									},
+								},
+								"bold": schema.BoolAttribute{
+									Optional: true,
+								},
+								"italic": schema.BoolAttribute{
+									Optional: true,
+								},
+								"fill_color": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										hexColor(),
+									},
+								},
+								"number_format": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						"color_scale": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"min_color": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										hexColor(),
+									},
+								},
+								// a three color scale if set
+								"mid_color": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										hexColor(),
+									},
+								},
+								"max_color": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										hexColor(),
+									},
+								},
+							},
+						},
+						"data_bar": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"color": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										hexColor(),
+									},
+								},
+							},
+						},
+						"icon_set": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"style": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringOneOf("3_arrows", "3_arrows_gray", "3_flags", "3_traffic_lights", "3_traffic_lights_rimmed",
+											"3_signs", "3_symbols", "3_symbols_circled", "4_arrows", "4_arrows_gray", "4_red_to_black",
+											"4_rating", "4_traffic_lights", "5_arrows", "5_arrows_gray", "5_rating", "5_quarters"),
+									},
+								},
+								"reverse": schema.BoolAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure every rule only sets the attributes of its type.
+func (r *conditionalFormatResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config conditionalFormatResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range config.Rules {
+		if rule.Type.IsUnknown() {
+			continue
+		}
+		ruleType := rule.Type.ValueString()
+		rulePath := path.Root("rules").AtListIndex(i)
+
+		// attributes that are set, and the rule types requiring them
+		attributes := []struct {
+			name  string
+			set   bool
+			types []string
+		}{
+			{"operator", !rule.Operator.IsNull(), []string{"cell_value"}},
+			{"values", rule.Values != nil, []string{"cell_value"}},
+			{"formula", !rule.Formula.IsNull(), []string{"expression"}},
+			{"format", rule.Format != nil, []string{"cell_value", "expression"}},
+			{"color_scale", rule.ColorScale != nil, []string{"color_scale"}},
+			{"data_bar", rule.DataBar != nil, []string{"data_bar"}},
+			{"icon_set", rule.IconSet != nil, []string{"icon_set"}},
+		}
+
+		for _, attribute := range attributes {
+			required := slices.Contains(attribute.types, ruleType)
+
+			if attribute.set && !required {
+				resp.Diagnostics.AddAttributeError(
+					rulePath.AtName(attribute.name),
+					"Invalid conditional format rule",
+					fmt.Sprintf("%s can not be set for %s rules", attribute.name, ruleType),
+				)
+			}
+			if !attribute.set && required {
+				resp.Diagnostics.AddAttributeError(
+					rulePath.AtName(attribute.name),
+					"Invalid conditional format rule",
+					fmt.Sprintf("%s is required for %s rules", attribute.name, ruleType),
+				)
+			}
+		}
+
+		// between compares to a lower and an upper value
+		if ruleType == "cell_value" && !rule.Operator.IsNull() && !rule.Operator.IsUnknown() && rule.Values != nil {
+			expected := 1
+			if operator := rule.Operator.ValueString(); operator == "between" || operator == "not_between" {
+				expected = 2
+			}
+			if len(rule.Values) != expected {
+				resp.Diagnostics.AddAttributeError(
+					rulePath.AtName("values"),
+					"Invalid conditional format rule",
+					fmt.Sprintf("operator %s takes %d values, got %d", rule.Operator.ValueString(), expected, len(rule.Values)),
+				)
+			}
+		}
+	}
+}
+
+func (r *conditionalFormatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan conditionalFormatResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// creates the conditional format with help of the client
+	format, err := createConditionalFormat(r.client, &conditionalFormat{
+		WorkbookID: plan.WorkbookID.ValueString(),
+		SheetID:    plan.SheetID.ValueString(),
+		Range:      plan.Range.ValueString(),
+		Rules:      expandConditionalFormatRules(plan.Rules),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating conditional format",
+			"Could not create conditional format for range "+plan.Range.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.ID = types.StringValue(format.ID)
+	plan.Range = types.StringValue(format.Range)
+	plan.Rules = flattenConditionalFormatRules(format.Rules)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *conditionalFormatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state conditionalFormatResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed conditional format from client
+	format, err := readConditionalFormat(r.client, &conditionalFormat{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+		SheetID:    state.SheetID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading conditional format",
+			"Could not read conditional format with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = types.StringValue(format.ID)
+	state.Range = types.StringValue(format.Range)
+	state.Rules = flattenConditionalFormatRules(format.Rules)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *conditionalFormatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state conditionalFormatResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteConditionalFormat(r.client, &conditionalFormat{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+		SheetID:    state.SheetID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting conditional format",
+			"Could not delete conditional format, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the conditional format
+func (r *conditionalFormatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state conditionalFormatResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan conditionalFormatResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	format, err := updateConditionalFormat(r.client, &conditionalFormat{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+		SheetID:    state.SheetID.ValueString(),
+		Range:      plan.Range.ValueString(),
+		Rules:      expandConditionalFormatRules(plan.Rules),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating conditional format",
+			"Could not update conditional format, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.ID = types.StringValue(format.ID)
+	plan.Range = types.StringValue(format.Range)
+	plan.Rules = flattenConditionalFormatRules(format.Rules)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *conditionalFormatResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func expandConditionalFormatRules(rules []conditionalFormatRuleModel) []conditionalFormatRule {
+	var result []conditionalFormatRule
+	for _, rule := range rules {
+		expanded := conditionalFormatRule{
+			Type:       rule.Type.ValueString(),
+			Priority:   knownInt64Pointer(rule.Priority),
+			StopIfTrue: rule.StopIfTrue.ValueBoolPointer(),
+			Operator:   rule.Operator.ValueStringPointer(),
+			Values:     expandStrings(rule.Values),
+			Formula:    rule.Formula.ValueStringPointer(),
+		}
+
+		if rule.Format != nil {
+			expanded.Format = &conditionalFormatFormat{
+				FontColor:    rule.Format.FontColor.ValueStringPointer(),
+				Bold:         rule.Format.Bold.ValueBoolPointer(),
+				Italic:       rule.Format.Italic.ValueBoolPointer(),
+				FillColor:    rule.Format.FillColor.ValueStringPointer(),
+				NumberFormat: rule.Format.NumberFormat.ValueStringPointer(),
+			}
+		}
+		if rule.ColorScale != nil {
+			expanded.ColorScale = &colorScale{
+				MinColor: rule.ColorScale.MinColor.ValueString(),
+				MidColor: rule.ColorScale.MidColor.ValueStringPointer(),
+				MaxColor: rule.ColorScale.MaxColor.ValueString(),
+			}
+		}
+		if rule.DataBar != nil {
+			expanded.DataBar = &dataBar{
+				Color: rule.DataBar.Color.ValueString(),
+			}
+		}
+		if rule.IconSet != nil {
+			expanded.IconSet = &iconSet{
+				Style:   rule.IconSet.Style.ValueString(),
+				Reverse: rule.IconSet.Reverse.ValueBoolPointer(),
+			}
+		}
+
+		result = append(result, expanded)
+	}
+	return result
+}
+
+func flattenConditionalFormatRules(rules []conditionalFormatRule) []conditionalFormatRuleModel {
+	var result []conditionalFormatRuleModel
+	for _, rule := range rules {
+		flattened := conditionalFormatRuleModel{
+			Type:       types.StringValue(rule.Type),
+			Priority:   types.Int64PointerValue(rule.Priority),
+			StopIfTrue: types.BoolPointerValue(rule.StopIfTrue),
+			Operator:   types.StringPointerValue(rule.Operator),
+			Values:     flattenStrings(rule.Values),
+			Formula:    types.StringPointerValue(rule.Formula),
+		}
+
+		if rule.Format != nil {
+			flattened.Format = &conditionalFormatModel{
+				FontColor:    types.StringPointerValue(rule.Format.FontColor),
+				Bold:         types.BoolPointerValue(rule.Format.Bold),
+				Italic:       types.BoolPointerValue(rule.Format.Italic),
+				FillColor:    types.StringPointerValue(rule.Format.FillColor),
+				NumberFormat: types.StringPointerValue(rule.Format.NumberFormat),
+			}
+		}
+		if rule.ColorScale != nil {
+			flattened.ColorScale = &colorScaleModel{
+				MinColor: types.StringValue(rule.ColorScale.MinColor),
+				MidColor: types.StringPointerValue(rule.ColorScale.MidColor),
+				MaxColor: types.StringValue(rule.ColorScale.MaxColor),
+			}
+		}
+		if rule.DataBar != nil {
+			flattened.DataBar = &dataBarModel{
+				Color: types.StringValue(rule.DataBar.Color),
+			}
+		}
+		if rule.IconSet != nil {
+			flattened.IconSet = &iconSetModel{
+				Style:   types.StringValue(rule.IconSet.Style),
+				Reverse: types.BoolPointerValue(rule.IconSet.Reverse),
+			}
+		}
+
+		result = append(result, flattened)
+	}
+	return result
+}
@@ -0,0 +1,176 @@
+package terraxcel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConditionalFormatCreatePriority(t *testing.T) {
+	server, c := newFakeServer(t)
+	endpoint := sheetEndpoint("wb-1", "sheet-1") + "/conditional-format"
+	server.handle("POST "+endpoint, http.StatusCreated, conditionalFormat{
+		ID:         "cf-1",
+		WorkbookID: "wb-1",
+		SheetID:    "sheet-1",
+		Range:      "E2:E40",
+		Rules: []conditionalFormatRule{
+			{Type: "expression", Priority: ptr(int64(3)), Formula: ptr("$E2<$D2"), Format: &conditionalFormatFormat{Bold: ptr(true)}},
+			{Type: "data_bar", Priority: ptr(int64(1)), DataBar: &dataBar{Color: "638EC6"}},
+		},
+	})
+
+	r := &conditionalFormatResource{client: c}
+	s := resourceSchema(t, r)
+	plan := conditionalFormatResourceModel{
+		ID:          types.StringUnknown(),
+		LastUpdated: types.StringUnknown(),
+		WorkbookID:  types.StringValue("wb-1"),
+		SheetID:     types.StringValue("sheet-1"),
+		Range:       types.StringValue("E2:E40"),
+		Rules: []conditionalFormatRuleModel{
+			{
+				Type:     types.StringValue("expression"),
+				Priority: types.Int64Unknown(),
+				Formula:  types.StringValue("$E2<$D2"),
+				Format:   &conditionalFormatModel{Bold: types.BoolValue(true)},
+			},
+			{
+				Type:     types.StringValue("data_bar"),
+				Priority: types.Int64Value(1),
+				DataBar:  &dataBarModel{Color: types.StringValue("638EC6")},
+			},
+		},
+	}
+
+	resp := &resource.CreateResponse{State: newState(t, s, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, plan)}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var sent conditionalFormat
+	server.decodeBody(t, "POST "+endpoint, &sent)
+	if sent.Rules[0].Priority != nil {
+		t.Errorf("sent priority %d for a rule without priority, the server assigns it", *sent.Rules[0].Priority)
+	}
+	if sent.Rules[1].Priority == nil || *sent.Rules[1].Priority != 1 {
+		t.Errorf("sent priority %v, want 1", sent.Rules[1].Priority)
+	}
+
+	var state conditionalFormatResourceModel
+	resp.State.Get(context.Background(), &state)
+	if !state.Rules[0].Priority.Equal(types.Int64Value(3)) {
+		t.Errorf("priority = %s, want the priority assigned by the server", state.Rules[0].Priority)
+	}
+}
+
+func TestConditionalFormatValidateConfig(t *testing.T) {
+	format := &conditionalFormatModel{FillColor: types.StringValue("FFC7CE")}
+
+	tests := []struct {
+		name  string
+		rule  conditionalFormatRuleModel
+		valid bool
+	}{
+		{
+			name: "cell value",
+			rule: conditionalFormatRuleModel{
+				Type:     types.StringValue("cell_value"),
+				Operator: types.StringValue("less_than"),
+				Values:   []types.String{types.StringValue("0")},
+				Format:   format,
+			},
+			valid: true,
+		},
+		{
+			name: "between",
+			rule: conditionalFormatRuleModel{
+				Type:     types.StringValue("cell_value"),
+				Operator: types.StringValue("between"),
+				Values:   []types.String{types.StringValue("0"), types.StringValue("10")},
+				Format:   format,
+			},
+			valid: true,
+		},
+		{
+			name: "between with one value",
+			rule: conditionalFormatRuleModel{
+				Type:     types.StringValue("cell_value"),
+				Operator: types.StringValue("between"),
+				Values:   []types.String{types.StringValue("0")},
+				Format:   format,
+			},
+		},
+		{
+			name: "cell value without operator",
+			rule: conditionalFormatRuleModel{
+				Type:   types.StringValue("cell_value"),
+				Values: []types.String{types.StringValue("0")},
+				Format: format,
+			},
+		},
+		{
+			name: "expression",
+			rule: conditionalFormatRuleModel{
+				Type:    types.StringValue("expression"),
+				Formula: types.StringValue("$E2<$D2"),
+				Format:  format,
+			},
+			valid: true,
+		},
+		{
+			name: "expression without formula",
+			rule: conditionalFormatRuleModel{
+				Type:   types.StringValue("expression"),
+				Format: format,
+			},
+		},
+		{
+			name: "data bar",
+			rule: conditionalFormatRuleModel{
+				Type:    types.StringValue("data_bar"),
+				DataBar: &dataBarModel{Color: types.StringValue("638EC6")},
+			},
+			valid: true,
+		},
+		{
+			name: "data bar with format",
+			rule: conditionalFormatRuleModel{
+				Type:    types.StringValue("data_bar"),
+				DataBar: &dataBarModel{Color: types.StringValue("638EC6")},
+				Format:  format,
+			},
+		},
+		{
+			name: "icon set without icon set",
+			rule: conditionalFormatRuleModel{
+				Type: types.StringValue("icon_set"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &conditionalFormatResource{}
+			s := resourceSchema(t, r)
+			config := conditionalFormatResourceModel{
+				WorkbookID: types.StringValue("wb-1"),
+				SheetID:    types.StringValue("sheet-1"),
+				Range:      types.StringValue("E2:E40"),
+				Rules:      []conditionalFormatRuleModel{test.rule},
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newConfig(t, s, config)}, resp)
+
+			if valid := !resp.Diagnostics.HasError(); valid != test.valid {
+				t.Errorf("valid = %v, want %v: %v", valid, test.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package terraxcel
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// expandStrings converts a list attribute to the strings sent to the server.
+func expandStrings(values []types.String) []string {
+	var result []string
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return result
+}
+
+// flattenStrings converts strings read from the server to a list attribute.
+func flattenStrings(values []string) []types.String {
+	var result []types.String
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
+
+// knownInt64Pointer converts an optional and computed attribute to the value
+// sent to the server, an unknown value is left for the server to set.
+func knownInt64Pointer(value types.Int64) *int64 {
+	if value.IsUnknown() {
+		return nil
+	}
+	return value.ValueInt64Pointer()
+}
@@ -0,0 +1,62 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// dataValidation restricts what can be entered in a range of cells.
+type dataValidation struct {
+	ID           string   `json:"id"`
+	WorkbookID   string   `json:"workbook_id"`
+	SheetID      string   `json:"sheet_id"`
+	Range        string   `json:"range"`
+	Type         string   `json:"type"`
+	Operator     *string  `json:"operator,omitempty"`
+	Formula1     *string  `json:"formula1,omitempty"`
+	Formula2     *string  `json:"formula2,omitempty"`
+	ListValues   []string `json:"list_values,omitempty"`
+	AllowBlank   *bool    `json:"allow_blank,omitempty"`
+	ShowDropdown *bool    `json:"show_dropdown,omitempty"`
+	InputTitle   *string  `json:"input_title,omitempty"`
+	InputMessage *string  `json:"input_message,omitempty"`
+	ErrorStyle   *string  `json:"error_style,omitempty"`
+	ErrorTitle   *string  `json:"error_title,omitempty"`
+	ErrorMessage *string  `json:"error_message,omitempty"`
+}
+
+func dataValidationEndpoint(validation *dataValidation) string {
+	return sheetEndpoint(validation.WorkbookID, validation.SheetID) + "/data-validation"
+}
+
+func createDataValidation(c *client.Client, validation *dataValidation) (*dataValidation, error) {
+	created := &dataValidation{}
+	err := doRequest(c, http.MethodPost, dataValidationEndpoint(validation), validation, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readDataValidation(c *client.Client, validation *dataValidation) (*dataValidation, error) {
+	read := &dataValidation{}
+	err := doRequest(c, http.MethodGet, dataValidationEndpoint(validation)+"/"+validation.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+func updateDataValidation(c *client.Client, validation *dataValidation) (*dataValidation, error) {
+	updated := &dataValidation{}
+	err := doRequest(c, http.MethodPut, dataValidationEndpoint(validation)+"/"+validation.ID, validation, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deleteDataValidation(c *client.Client, validation *dataValidation) error {
+	return doRequest(c, http.MethodDelete, dataValidationEndpoint(validation)+"/"+validation.ID, nil, nil, http.StatusOK)
+}
@@ -0,0 +1,376 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &dataValidationResource{}
+	_ resource.ResourceWithConfigure      = &dataValidationResource{}
+	_ resource.ResourceWithValidateConfig = &dataValidationResource{}
+)
+
+// maxListLength is the longest list of values Excel accepts in a dropdown,
+// including the separating commas.
+const maxListLength = 255
+
+// NewDataValidationResource is a helper function to simplify the provider implementation.
+func NewDataValidationResource() resource.Resource {
+	return &dataValidationResource{}
+}
+
+type dataValidationResource struct {
+	client *client.Client
+}
+
+type dataValidationResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	WorkbookID   types.String   `tfsdk:"workbook_id"`
+	SheetID      types.String   `tfsdk:"sheet_id"`
+	Range        types.String   `tfsdk:"range"`
+	Type         types.String   `tfsdk:"type"`
+	Operator     types.String   `tfsdk:"operator"`
+	Formula1     types.String   `tfsdk:"formula1"`
+	Formula2     types.String   `tfsdk:"formula2"`
+	ListValues   []types.String `tfsdk:"list_values"`
+	AllowBlank   types.Bool     `tfsdk:"allow_blank"`
+	ShowDropdown types.Bool     `tfsdk:"show_dropdown"`
+	InputTitle   types.String   `tfsdk:"input_title"`
+	InputMessage types.String   `tfsdk:"input_message"`
+	ErrorStyle   types.String   `tfsdk:"error_style"`
+	ErrorTitle   types.String   `tfsdk:"error_title"`
+	ErrorMessage types.String   `tfsdk:"error_message"`
+}
+
+func (m *dataValidationResourceModel) expand() *dataValidation {
+	return &dataValidation{
+		ID:           m.ID.ValueString(),
+		WorkbookID:   m.WorkbookID.ValueString(),
+		SheetID:      m.SheetID.ValueString(),
+		Range:        m.Range.ValueString(),
+		Type:         m.Type.ValueString(),
+		Operator:     m.Operator.ValueStringPointer(),
+		Formula1:     m.Formula1.ValueStringPointer(),
+		Formula2:     m.Formula2.ValueStringPointer(),
+		ListValues:   expandStrings(m.ListValues),
+		AllowBlank:   m.AllowBlank.ValueBoolPointer(),
+		ShowDropdown: m.ShowDropdown.ValueBoolPointer(),
+		InputTitle:   m.InputTitle.ValueStringPointer(),
+		InputMessage: m.InputMessage.ValueStringPointer(),
+		ErrorStyle:   m.ErrorStyle.ValueStringPointer(),
+		ErrorTitle:   m.ErrorTitle.ValueStringPointer(),
+		ErrorMessage: m.ErrorMessage.ValueStringPointer(),
+	}
+}
+
+func (m *dataValidationResourceModel) flatten(validation *dataValidation) {
+	m.ID = types.StringValue(validation.ID)
+	m.Range = types.StringValue(validation.Range)
+	m.Type = types.StringValue(validation.Type)
+	m.Operator = types.StringPointerValue(validation.Operator)
+	m.Formula1 = types.StringPointerValue(validation.Formula1)
+	m.Formula2 = types.StringPointerValue(validation.Formula2)
+	m.ListValues = flattenStrings(validation.ListValues)
+	m.AllowBlank = types.BoolPointerValue(validation.AllowBlank)
+	m.ShowDropdown = types.BoolPointerValue(validation.ShowDropdown)
+	m.InputTitle = types.StringPointerValue(validation.InputTitle)
+	m.InputMessage = types.StringPointerValue(validation.InputMessage)
+	m.ErrorStyle = types.StringPointerValue(validation.ErrorStyle)
+	m.ErrorTitle = types.StringPointerValue(validation.ErrorTitle)
+	m.ErrorMessage = types.StringPointerValue(validation.ErrorMessage)
+}
+
+// Metadata returns the resource type name.
+func (r *dataValidationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_validation"
+}
+
+// Schema defines the schema for the resource.
+func (r *dataValidationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"range": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					cellReference(true),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringOneOf("list", "whole", "decimal", "date", "time", "text_length", "custom"),
+				},
+			},
+			"operator": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringOneOf("between", "not_between", "equal", "not_equal", "greater_than",
+						"less_than", "greater_than_or_equal", "less_than_or_equal"),
+				},
+			},
+			// a value or formula, formula2 is the upper value of between
+			"formula1": schema.StringAttribute{
+				Optional: true,
+			},
+			"formula2": schema.StringAttribute{
+				Optional: true,
+			},
+			// the values of a list, instead of a formula1 referring to a range
+			"list_values": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"allow_blank": schema.BoolAttribute{
+				Optional: true,
+			},
+			"show_dropdown": schema.BoolAttribute{
+				Optional: true,
+			},
+			"input_title": schema.StringAttribute{
+				Optional: true,
+			},
+			"input_message": schema.StringAttribute{
+				Optional: true,
+			},
+			"error_style": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringOneOf("stop", "warning", "information"),
+				},
+			},
+			"error_title": schema.StringAttribute{
+				Optional: true,
+			},
+			"error_message": schema.StringAttribute{
+				Optional: true,
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure the operator and formulas match the type.
+func (r *dataValidationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dataValidationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+
+	validationType := config.Type.ValueString()
+	invalid := func(attribute, message string) {
+		resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid data validation", message)
+	}
+
+	switch validationType {
+	case "list":
+		if config.ListValues == nil && config.Formula1.IsNull() {
+			invalid("list_values", "list validations require list_values or a formula1 referring to the values")
+		}
+		if config.ListValues != nil && !config.Formula1.IsNull() {
+			invalid("list_values", "list_values can not be combined with formula1")
+		}
+		if len(strings.Join(expandStrings(config.ListValues), ",")) > maxListLength {
+			invalid("list_values", fmt.Sprintf("list_values can be at most %d characters long, including the separating commas", maxListLength))
+		}
+	case "custom":
+		if config.Formula1.IsNull() {
+			invalid("formula1", "custom validations require formula1")
+		}
+	default:
+		if config.Operator.IsNull() {
+			invalid("operator", fmt.Sprintf("%s validations require operator", validationType))
+		}
+		if config.Formula1.IsNull() {
+			invalid("formula1", fmt.Sprintf("%s validations require formula1", validationType))
+		}
+
+		between := config.Operator.ValueString() == "between" || config.Operator.ValueString() == "not_between"
+		if between && config.Formula2.IsNull() {
+			invalid("formula2", fmt.Sprintf("operator %s requires formula2", config.Operator.ValueString()))
+		}
+		if !between && !config.Operator.IsUnknown() && !config.Formula2.IsNull() {
+			invalid("formula2", "formula2 can only be set for the between and not_between operators")
+		}
+	}
+
+	if validationType != "list" && config.ListValues != nil {
+		invalid("list_values", "list_values can only be set for list validations")
+	}
+	if validationType != "list" && !config.ShowDropdown.IsNull() {
+		invalid("show_dropdown", "show_dropdown can only be set for list validations")
+	}
+	if (validationType == "list" || validationType == "custom") && !config.Operator.IsNull() {
+		invalid("operator", fmt.Sprintf("operator can not be set for %s validations", validationType))
+	}
+	if (validationType == "list" || validationType == "custom") && !config.Formula2.IsNull() {
+		invalid("formula2", fmt.Sprintf("formula2 can not be set for %s validations", validationType))
+	}
+}
+
+func (r *dataValidationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan dataValidationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// creates the data validation with help of the client
+	validation, err := createDataValidation(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating data validation",
+			"Could not create data validation for range "+plan.Range.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(validation)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *dataValidationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dataValidationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed data validation from client
+	validation, err := readDataValidation(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading data validation",
+			"Could not read data validation with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(validation)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dataValidationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dataValidationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteDataValidation(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting data validation",
+			"Could not delete data validation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the data validation
+func (r *dataValidationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state dataValidationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan dataValidationResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	validation, err := updateDataValidation(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating data validation",
+			"Could not update data validation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(validation)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dataValidationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
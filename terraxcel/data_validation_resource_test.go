@@ -0,0 +1,102 @@
+package terraxcel
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataValidationValidateConfig(t *testing.T) {
+	values := func(values ...string) []types.String {
+		return flattenStrings(values)
+	}
+
+	tests := []struct {
+		name   string
+		config dataValidationResourceModel
+		valid  bool
+	}{
+		{
+			name:   "list",
+			config: dataValidationResourceModel{Type: types.StringValue("list"), ListValues: values("North", "South"), ShowDropdown: types.BoolValue(true)},
+			valid:  true,
+		},
+		{
+			name:   "list from a range",
+			config: dataValidationResourceModel{Type: types.StringValue("list"), Formula1: types.StringValue("=Lookup!$A$1:$A$4")},
+			valid:  true,
+		},
+		{
+			name:   "list without values",
+			config: dataValidationResourceModel{Type: types.StringValue("list")},
+		},
+		{
+			name:   "list with values and a range",
+			config: dataValidationResourceModel{Type: types.StringValue("list"), ListValues: values("North"), Formula1: types.StringValue("=Lookup!$A$1:$A$4")},
+		},
+		{
+			name:   "list longer than Excel allows",
+			config: dataValidationResourceModel{Type: types.StringValue("list"), ListValues: values(strings.Repeat("a", 200), strings.Repeat("b", 60))},
+		},
+		{
+			name:   "list with operator",
+			config: dataValidationResourceModel{Type: types.StringValue("list"), ListValues: values("North"), Operator: types.StringValue("equal")},
+		},
+		{
+			name:   "whole number between",
+			config: dataValidationResourceModel{Type: types.StringValue("whole"), Operator: types.StringValue("between"), Formula1: types.StringValue("0"), Formula2: types.StringValue("100")},
+			valid:  true,
+		},
+		{
+			name:   "between without upper bound",
+			config: dataValidationResourceModel{Type: types.StringValue("whole"), Operator: types.StringValue("between"), Formula1: types.StringValue("0")},
+		},
+		{
+			name:   "upper bound without between",
+			config: dataValidationResourceModel{Type: types.StringValue("decimal"), Operator: types.StringValue("greater_than"), Formula1: types.StringValue("0"), Formula2: types.StringValue("100")},
+		},
+		{
+			name:   "date without operator",
+			config: dataValidationResourceModel{Type: types.StringValue("date"), Formula1: types.StringValue("DATE(2024,1,1)")},
+		},
+		{
+			name:   "text length with dropdown",
+			config: dataValidationResourceModel{Type: types.StringValue("text_length"), Operator: types.StringValue("less_than_or_equal"), Formula1: types.StringValue("50"), ShowDropdown: types.BoolValue(true)},
+		},
+		{
+			name:   "custom",
+			config: dataValidationResourceModel{Type: types.StringValue("custom"), Formula1: types.StringValue("=ISNUMBER(B2)")},
+			valid:  true,
+		},
+		{
+			name:   "custom without formula",
+			config: dataValidationResourceModel{Type: types.StringValue("custom")},
+		},
+		{
+			name:   "unknown type",
+			config: dataValidationResourceModel{Type: types.StringUnknown(), Operator: types.StringValue("between")},
+			valid:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &dataValidationResource{}
+			s := resourceSchema(t, r)
+			config := test.config
+			config.WorkbookID = types.StringValue("wb-1")
+			config.SheetID = types.StringValue("sheet-1")
+			config.Range = types.StringValue("B2:B40")
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newConfig(t, s, config)}, resp)
+
+			if valid := !resp.Diagnostics.HasError(); valid != test.valid {
+				t.Errorf("valid = %v, want %v: %v", valid, test.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// definedName is a name referring to a range, formula or constant, scoped to
+// the workbook or to a single sheet when SheetID is set.
+type definedName struct {
+	ID         string  `json:"id"`
+	WorkbookID string  `json:"workbook_id"`
+	SheetID    *string `json:"sheet_id,omitempty"`
+	Name       string  `json:"name"`
+	RefersTo   string  `json:"refers_to"`
+	Comment    *string `json:"comment,omitempty"`
+	Hidden     *bool   `json:"hidden,omitempty"`
+}
+
+func definedNameEndpoint(name *definedName) string {
+	return workbookEndpoint(name.WorkbookID) + "/defined-name"
+}
+
+func createDefinedName(c *client.Client, name *definedName) (*definedName, error) {
+	created := &definedName{}
+	err := doRequest(c, http.MethodPost, definedNameEndpoint(name), name, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readDefinedName(c *client.Client, name *definedName) (*definedName, error) {
+	read := &definedName{}
+	err := doRequest(c, http.MethodGet, definedNameEndpoint(name)+"/"+name.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+// readDefinedNames reads the names of the workbook, of all scopes.
+func readDefinedNames(c *client.Client, workbookID string) ([]definedName, error) {
+	var names []definedName
+	err := doRequest(c, http.MethodGet, workbookEndpoint(workbookID)+"/defined-name", nil, &names, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+func updateDefinedName(c *client.Client, name *definedName) (*definedName, error) {
+	updated := &definedName{}
+	err := doRequest(c, http.MethodPut, definedNameEndpoint(name)+"/"+name.ID, name, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deleteDefinedName(c *client.Client, name *definedName) error {
+	return doRequest(c, http.MethodDelete, definedNameEndpoint(name)+"/"+name.ID, nil, nil, http.StatusOK)
+}
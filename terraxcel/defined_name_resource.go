@@ -0,0 +1,362 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &definedNameResource{}
+	_ resource.ResourceWithConfigure      = &definedNameResource{}
+	_ resource.ResourceWithModifyPlan     = &definedNameResource{}
+	_ resource.ResourceWithValidateConfig = &definedNameResource{}
+)
+
+// NewDefinedNameResource is a helper function to simplify the provider implementation.
+func NewDefinedNameResource() resource.Resource {
+	return &definedNameResource{}
+}
+
+type definedNameResource struct {
+	client *client.Client
+}
+
+type definedNameResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	WorkbookID  types.String `tfsdk:"workbook_id"`
+	SheetID     types.String `tfsdk:"sheet_id"`
+	Name        types.String `tfsdk:"name"`
+	RefersTo    types.String `tfsdk:"refers_to"`
+	Comment     types.String `tfsdk:"comment"`
+	Hidden      types.Bool   `tfsdk:"hidden"`
+}
+
+func (m *definedNameResourceModel) expand() *definedName {
+	return &definedName{
+		ID:         m.ID.ValueString(),
+		WorkbookID: m.WorkbookID.ValueString(),
+		SheetID:    m.SheetID.ValueStringPointer(),
+		Name:       m.Name.ValueString(),
+		RefersTo:   m.RefersTo.ValueString(),
+		Comment:    m.Comment.ValueStringPointer(),
+		Hidden:     m.Hidden.ValueBoolPointer(),
+	}
+}
+
+func (m *definedNameResourceModel) flatten(name *definedName) {
+	m.ID = types.StringValue(name.ID)
+	m.SheetID = types.StringPointerValue(name.SheetID)
+	m.Name = types.StringValue(name.Name)
+	m.RefersTo = types.StringValue(name.RefersTo)
+	m.Comment = types.StringPointerValue(name.Comment)
+	m.Hidden = types.BoolPointerValue(name.Hidden)
+}
+
+// Metadata returns the resource type name.
+func (r *definedNameResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_defined_name"
+}
+
+// Schema defines the schema for the resource.
+func (r *definedNameResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// scopes the name to a sheet, names are scoped to the workbook
+			// without it
+			"sheet_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					excelName(),
+				},
+			},
+			// a range, formula or constant, e.g. =Summary!$B$2 or =0.21
+			"refers_to": schema.StringAttribute{
+				Required: true,
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+			// hides the name from the name manager
+			"hidden": schema.BoolAttribute{
+				Optional: true,
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure refers_to is a formula.
+func (r *definedNameResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config definedNameResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.RefersTo.IsUnknown() {
+		return
+	}
+
+	refersTo := config.RefersTo.ValueString()
+	if !strings.HasPrefix(refersTo, "=") || len(refersTo) == 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("refers_to"),
+			"Invalid defined name",
+			fmt.Sprintf("refers_to must be a formula starting with =, e.g. =Summary!$B$2 or =0.21, got %q", refersTo),
+		)
+	}
+}
+
+// ModifyPlan makes sure the name is unique within its scope. Names scoped to
+// the workbook also share their names with the tables of the workbook.
+func (r *definedNameResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check when the name is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan definedNameResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only new and renamed names are checked
+	if !req.State.Raw.IsNull() {
+		var state definedNameResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Name.Equal(state.Name) {
+			return
+		}
+	}
+
+	// the workbook may not exist yet, or the provider not be configured
+	if r.client == nil || plan.WorkbookID.IsUnknown() || plan.SheetID.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	names, err := readDefinedNames(r.client, plan.WorkbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading defined names",
+			"Could not read the defined names of workbook "+plan.WorkbookID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, existing := range names {
+		if !plan.ID.IsUnknown() && existing.ID == plan.ID.ValueString() {
+			continue
+		}
+
+		// defined names are not case sensitive
+		sameScope := types.StringPointerValue(existing.SheetID).Equal(plan.SheetID)
+		if sameScope && strings.EqualFold(existing.Name, plan.Name.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Duplicate defined name",
+				fmt.Sprintf("the %s already has a name %s, defined names must be unique within their scope", definedNameScope(plan.SheetID), existing.Name),
+			)
+			return
+		}
+	}
+
+	if !plan.SheetID.IsNull() {
+		return
+	}
+
+	tables, err := readExcelTables(r.client, plan.WorkbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading tables",
+			"Could not read the tables of workbook "+plan.WorkbookID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, table := range tables {
+		if strings.EqualFold(table.Name, plan.Name.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Duplicate defined name",
+				fmt.Sprintf("the workbook already has a table named %s, names scoped to the workbook can not be the name of a table", table.Name),
+			)
+		}
+	}
+}
+
+// definedNameScope describes the scope of a name in diagnostics.
+func definedNameScope(sheetID types.String) string {
+	if sheetID.IsNull() {
+		return "workbook"
+	}
+	return "sheet " + sheetID.ValueString()
+}
+
+func (r *definedNameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan definedNameResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// creates the defined name with help of the client
+	name, err := createDefinedName(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating defined name",
+			"Could not create defined name "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(name)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *definedNameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state definedNameResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed defined name from client
+	name, err := readDefinedName(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading defined name",
+			"Could not read defined name with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(name)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *definedNameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state definedNameResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteDefinedName(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting defined name",
+			"Could not delete defined name, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the defined name
+func (r *definedNameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state definedNameResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan definedNameResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	name, err := updateDefinedName(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating defined name",
+			"Could not update defined name, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(name)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *definedNameResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
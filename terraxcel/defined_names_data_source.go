@@ -0,0 +1,112 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &definedNamesDataSource{}
+	_ datasource.DataSourceWithConfigure = &definedNamesDataSource{}
+)
+
+func NewDefinedNamesDataSource() datasource.DataSource {
+	return &definedNamesDataSource{}
+}
+
+type definedNamesDataSource struct {
+	client *client.Client
+}
+
+type definedNamesDataSourceModel struct {
+	WorkbookID types.String       `tfsdk:"workbook_id"`
+	Names      []definedNameModel `tfsdk:"names"`
+}
+
+type definedNameModel struct {
+	ID       types.String `tfsdk:"id"`
+	SheetID  types.String `tfsdk:"sheet_id"`
+	Name     types.String `tfsdk:"name"`
+	RefersTo types.String `tfsdk:"refers_to"`
+	Comment  types.String `tfsdk:"comment"`
+	Hidden   types.Bool   `tfsdk:"hidden"`
+}
+
+func (d *definedNamesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_defined_names"
+}
+
+func (d *definedNamesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+			},
+			// the names of all scopes, sheet_id is null for names scoped
+			// to the workbook
+			"names": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":        schema.StringAttribute{Computed: true},
+						"sheet_id":  schema.StringAttribute{Computed: true},
+						"name":      schema.StringAttribute{Computed: true},
+						"refers_to": schema.StringAttribute{Computed: true},
+						"comment":   schema.StringAttribute{Computed: true},
+						"hidden":    schema.BoolAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *definedNamesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state definedNamesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, err := readDefinedNames(d.client, state.WorkbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read defined names",
+			fmt.Sprintf("Unable to read the defined names of workbook with ID %s: %s", state.WorkbookID.ValueString(), err),
+		)
+		return
+	}
+
+	// maps response from client to state
+	for _, name := range names {
+		state.Names = append(state.Names, definedNameModel{
+			ID:       types.StringValue(name.ID),
+			SheetID:  types.StringPointerValue(name.SheetID),
+			Name:     types.StringValue(name.Name),
+			RefersTo: types.StringValue(name.RefersTo),
+			Comment:  types.StringPointerValue(name.Comment),
+			Hidden:   types.BoolPointerValue(name.Hidden),
+		})
+	}
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *definedNamesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
@@ -0,0 +1,103 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// column sets the width and visibility of one or more columns.
+type column struct {
+	ID           string   `json:"id"`
+	WorkbookID   string   `json:"workbook_id"`
+	SheetID      string   `json:"sheet_id"`
+	Columns      string   `json:"columns"`
+	Width        *float64 `json:"width,omitempty"`
+	AutoFit      *bool    `json:"auto_fit,omitempty"`
+	Hidden       *bool    `json:"hidden,omitempty"`
+	OutlineLevel *int64   `json:"outline_level,omitempty"`
+}
+
+// row sets the height and visibility of one or more rows.
+type row struct {
+	ID           string   `json:"id"`
+	WorkbookID   string   `json:"workbook_id"`
+	SheetID      string   `json:"sheet_id"`
+	Rows         string   `json:"rows"`
+	Height       *float64 `json:"height,omitempty"`
+	AutoFit      *bool    `json:"auto_fit,omitempty"`
+	Hidden       *bool    `json:"hidden,omitempty"`
+	OutlineLevel *int64   `json:"outline_level,omitempty"`
+}
+
+func columnEndpoint(sheetColumn *column) string {
+	return sheetEndpoint(sheetColumn.WorkbookID, sheetColumn.SheetID) + "/column"
+}
+
+func createColumn(c *client.Client, sheetColumn *column) (*column, error) {
+	created := &column{}
+	err := doRequest(c, http.MethodPost, columnEndpoint(sheetColumn), sheetColumn, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readColumn(c *client.Client, sheetColumn *column) (*column, error) {
+	read := &column{}
+	err := doRequest(c, http.MethodGet, columnEndpoint(sheetColumn)+"/"+sheetColumn.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+func updateColumn(c *client.Client, sheetColumn *column) (*column, error) {
+	updated := &column{}
+	err := doRequest(c, http.MethodPut, columnEndpoint(sheetColumn)+"/"+sheetColumn.ID, sheetColumn, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// deleteColumn resets the columns to the default width and makes them visible.
+func deleteColumn(c *client.Client, sheetColumn *column) error {
+	return doRequest(c, http.MethodDelete, columnEndpoint(sheetColumn)+"/"+sheetColumn.ID, nil, nil, http.StatusOK)
+}
+
+func rowEndpoint(sheetRow *row) string {
+	return sheetEndpoint(sheetRow.WorkbookID, sheetRow.SheetID) + "/row"
+}
+
+func createRow(c *client.Client, sheetRow *row) (*row, error) {
+	created := &row{}
+	err := doRequest(c, http.MethodPost, rowEndpoint(sheetRow), sheetRow, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readRow(c *client.Client, sheetRow *row) (*row, error) {
+	read := &row{}
+	err := doRequest(c, http.MethodGet, rowEndpoint(sheetRow)+"/"+sheetRow.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+func updateRow(c *client.Client, sheetRow *row) (*row, error) {
+	updated := &row{}
+	err := doRequest(c, http.MethodPut, rowEndpoint(sheetRow)+"/"+sheetRow.ID, sheetRow, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// deleteRow resets the rows to the default height and makes them visible.
+func deleteRow(c *client.Client, sheetRow *row) error {
+	return doRequest(c, http.MethodDelete, rowEndpoint(sheetRow)+"/"+sheetRow.ID, nil, nil, http.StatusOK)
+}
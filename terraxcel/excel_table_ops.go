@@ -0,0 +1,79 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// excelTable is an Excel table, a range of cells that can be sorted, filtered
+// and referred to by its name, e.g. Sales[Amount].
+type excelTable struct {
+	ID            string        `json:"id"`
+	WorkbookID    string        `json:"workbook_id"`
+	SheetID       string        `json:"sheet_id"`
+	Name          string        `json:"name"`
+	Range         string        `json:"range"`
+	HeaderRow     *bool         `json:"header_row,omitempty"`
+	TotalsRow     *bool         `json:"totals_row,omitempty"`
+	Columns       []tableColumn `json:"columns,omitempty"`
+	Style         *string       `json:"style,omitempty"`
+	BandedRows    *bool         `json:"banded_rows,omitempty"`
+	BandedColumns *bool         `json:"banded_columns,omitempty"`
+	FirstColumn   *bool         `json:"first_column,omitempty"`
+	LastColumn    *bool         `json:"last_column,omitempty"`
+}
+
+// tableColumn is a column of a table, the totals are only shown when the
+// table has a totals row.
+type tableColumn struct {
+	Name           string  `json:"name"`
+	TotalsFunction *string `json:"totals_function,omitempty"`
+	TotalsFormula  *string `json:"totals_formula,omitempty"`
+	TotalsLabel    *string `json:"totals_label,omitempty"`
+}
+
+func excelTableEndpoint(table *excelTable) string {
+	return sheetEndpoint(table.WorkbookID, table.SheetID) + "/table"
+}
+
+func createExcelTable(c *client.Client, table *excelTable) (*excelTable, error) {
+	created := &excelTable{}
+	err := doRequest(c, http.MethodPost, excelTableEndpoint(table), table, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readExcelTable(c *client.Client, table *excelTable) (*excelTable, error) {
+	read := &excelTable{}
+	err := doRequest(c, http.MethodGet, excelTableEndpoint(table)+"/"+table.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+// readExcelTables reads the tables of all sheets in the workbook.
+func readExcelTables(c *client.Client, workbookID string) ([]excelTable, error) {
+	var tables []excelTable
+	err := doRequest(c, http.MethodGet, workbookEndpoint(workbookID)+"/table", nil, &tables, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+func updateExcelTable(c *client.Client, table *excelTable) (*excelTable, error) {
+	updated := &excelTable{}
+	err := doRequest(c, http.MethodPut, excelTableEndpoint(table)+"/"+table.ID, table, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deleteExcelTable(c *client.Client, table *excelTable) error {
+	return doRequest(c, http.MethodDelete, excelTableEndpoint(table)+"/"+table.ID, nil, nil, http.StatusOK)
+}
@@ -0,0 +1,533 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &excelTableResource{}
+	_ resource.ResourceWithConfigure      = &excelTableResource{}
+	_ resource.ResourceWithModifyPlan     = &excelTableResource{}
+	_ resource.ResourceWithValidateConfig = &excelTableResource{}
+)
+
+// NewExcelTableResource is a helper function to simplify the provider implementation.
+func NewExcelTableResource() resource.Resource {
+	return &excelTableResource{}
+}
+
+type excelTableResource struct {
+	client *client.Client
+}
+
+type excelTableResourceModel struct {
+	ID            types.String       `tfsdk:"id"`
+	LastUpdated   types.String       `tfsdk:"last_updated"`
+	WorkbookID    types.String       `tfsdk:"workbook_id"`
+	SheetID       types.String       `tfsdk:"sheet_id"`
+	Name          types.String       `tfsdk:"name"`
+	Range         types.String       `tfsdk:"range"`
+	HeaderRow     types.Bool         `tfsdk:"header_row"`
+	TotalsRow     types.Bool         `tfsdk:"totals_row"`
+	Columns       []tableColumnModel `tfsdk:"columns"`
+	Style         types.String       `tfsdk:"style"`
+	BandedRows    types.Bool         `tfsdk:"banded_rows"`
+	BandedColumns types.Bool         `tfsdk:"banded_columns"`
+	FirstColumn   types.Bool         `tfsdk:"first_column"`
+	LastColumn    types.Bool         `tfsdk:"last_column"`
+}
+
+type tableColumnModel struct {
+	Name           types.String `tfsdk:"name"`
+	TotalsFunction types.String `tfsdk:"totals_function"`
+	TotalsFormula  types.String `tfsdk:"totals_formula"`
+	TotalsLabel    types.String `tfsdk:"totals_label"`
+}
+
+func (m *excelTableResourceModel) expand() *excelTable {
+	table := &excelTable{
+		ID:            m.ID.ValueString(),
+		WorkbookID:    m.WorkbookID.ValueString(),
+		SheetID:       m.SheetID.ValueString(),
+		Name:          m.Name.ValueString(),
+		Range:         m.Range.ValueString(),
+		HeaderRow:     m.HeaderRow.ValueBoolPointer(),
+		TotalsRow:     m.TotalsRow.ValueBoolPointer(),
+		Style:         m.Style.ValueStringPointer(),
+		BandedRows:    m.BandedRows.ValueBoolPointer(),
+		BandedColumns: m.BandedColumns.ValueBoolPointer(),
+		FirstColumn:   m.FirstColumn.ValueBoolPointer(),
+		LastColumn:    m.LastColumn.ValueBoolPointer(),
+	}
+
+	for _, column := range m.Columns {
+		table.Columns = append(table.Columns, tableColumn{
+			Name:           column.Name.ValueString(),
+			TotalsFunction: column.TotalsFunction.ValueStringPointer(),
+			TotalsFormula:  column.TotalsFormula.ValueStringPointer(),
+			TotalsLabel:    column.TotalsLabel.ValueStringPointer(),
+		})
+	}
+
+	return table
+}
+
+// flatten only sets the columns when they are configured, the server names
+// the columns of tables without them.
+func (m *excelTableResourceModel) flatten(table *excelTable) {
+	m.ID = types.StringValue(table.ID)
+	m.Name = types.StringValue(table.Name)
+	m.Range = types.StringValue(table.Range)
+	m.HeaderRow = types.BoolPointerValue(table.HeaderRow)
+	m.TotalsRow = types.BoolPointerValue(table.TotalsRow)
+	m.Style = types.StringPointerValue(table.Style)
+	m.BandedRows = types.BoolPointerValue(table.BandedRows)
+	m.BandedColumns = types.BoolPointerValue(table.BandedColumns)
+	m.FirstColumn = types.BoolPointerValue(table.FirstColumn)
+	m.LastColumn = types.BoolPointerValue(table.LastColumn)
+
+	if m.Columns == nil {
+		return
+	}
+
+	m.Columns = nil
+	for _, column := range table.Columns {
+		m.Columns = append(m.Columns, tableColumnModel{
+			Name:           types.StringValue(column.Name),
+			TotalsFunction: types.StringPointerValue(column.TotalsFunction),
+			TotalsFormula:  types.StringPointerValue(column.TotalsFormula),
+			TotalsLabel:    types.StringPointerValue(column.TotalsLabel),
+		})
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *excelTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_excel_table"
+}
+
+// Schema defines the schema for the resource.
+func (r *excelTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// name used in structured references, e.g. Sales[Amount]
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					excelName(),
+				},
+			},
+			// the whole table, including the header and totals rows
+			"range": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					cellReference(true),
+				},
+			},
+			"header_row": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"totals_row": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			// one column for every column of the range, from left to right
+			"columns": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"totals_function": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringOneOf("sum", "average", "count", "count_numbers", "min", "max", "std_dev", "var", "custom"),
+							},
+						},
+						// formula of the custom totals function
+						"totals_formula": schema.StringAttribute{
+							Optional: true,
+						},
+						// text shown in the totals row instead of a function
+						"totals_label": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			// one of the built in table styles, e.g. TableStyleLight9
+			"style": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("TableStyleMedium2"),
+			},
+			"banded_rows": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"banded_columns": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"first_column": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"last_column": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure the range fits the header, totals and columns of
+// the table.
+func (r *excelTableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config excelTableResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invalid := func(attribute path.Path, message string) {
+		resp.Diagnostics.AddAttributeError(attribute, "Invalid table", message)
+	}
+
+	totalsRow := config.TotalsRow.ValueBool()
+	names := map[string]bool{}
+	for i, column := range config.Columns {
+		columnPath := path.Root("columns").AtListIndex(i)
+
+		if !column.Name.IsUnknown() {
+			name := strings.ToLower(column.Name.ValueString())
+			if name == "" {
+				invalid(columnPath.AtName("name"), "column names can not be empty")
+			}
+			if names[name] {
+				invalid(columnPath.AtName("name"), fmt.Sprintf("column name %q is used more than once, column names are not case sensitive", column.Name.ValueString()))
+			}
+			names[name] = true
+		}
+
+		if !config.TotalsRow.IsUnknown() && !totalsRow && (!column.TotalsFunction.IsNull() || !column.TotalsLabel.IsNull()) {
+			invalid(columnPath, "totals_function and totals_label require totals_row")
+		}
+		if !column.TotalsFunction.IsNull() && !column.TotalsLabel.IsNull() {
+			invalid(columnPath.AtName("totals_label"), "totals_label can not be combined with totals_function")
+		}
+
+		custom := column.TotalsFunction.ValueString() == "custom"
+		if custom && column.TotalsFormula.IsNull() {
+			invalid(columnPath.AtName("totals_formula"), "totals_function custom requires totals_formula")
+		}
+		if !custom && !column.TotalsFunction.IsUnknown() && !column.TotalsFormula.IsNull() {
+			invalid(columnPath.AtName("totals_formula"), "totals_formula can only be set for totals_function custom")
+		}
+	}
+
+	if config.Range.IsUnknown() || config.HeaderRow.IsUnknown() || config.TotalsRow.IsUnknown() {
+		return
+	}
+
+	tableRange, err := parseCellRange(config.Range.ValueString())
+	if err != nil {
+		return
+	}
+
+	// a table needs at least one data row next to its header and totals
+	rows := 1
+	if config.HeaderRow.IsNull() || config.HeaderRow.ValueBool() {
+		rows++
+	}
+	if totalsRow {
+		rows++
+	}
+	if tableRange.LastRow-tableRange.FirstRow+1 < rows {
+		invalid(path.Root("range"), fmt.Sprintf("range %s needs at least %d rows for the header, totals and a data row", config.Range.ValueString(), rows))
+	}
+
+	width := tableRange.LastColumn - tableRange.FirstColumn + 1
+	if config.Columns != nil && len(config.Columns) != width {
+		invalid(path.Root("columns"), fmt.Sprintf("range %s has %d columns, got %d columns", config.Range.ValueString(), width, len(config.Columns)))
+	}
+}
+
+// ModifyPlan makes sure the name of the table is unique within the workbook,
+// also among the defined names, and that the table does not overlap other
+// tables.
+func (r *excelTableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check when the table is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan excelTableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only new tables, and tables that are renamed or resized are checked
+	if !req.State.Raw.IsNull() {
+		var state excelTableResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Name.Equal(state.Name) && plan.Range.Equal(state.Range) {
+			return
+		}
+	}
+
+	// the sheet may not exist yet, or the provider not be configured
+	if r.client == nil || plan.WorkbookID.IsUnknown() || plan.SheetID.IsUnknown() || plan.Name.IsUnknown() || plan.Range.IsUnknown() {
+		return
+	}
+
+	tableRange, err := parseCellRange(plan.Range.ValueString())
+	if err != nil {
+		return
+	}
+
+	tables, err := readExcelTables(r.client, plan.WorkbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading tables",
+			"Could not read the tables of workbook "+plan.WorkbookID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, existing := range tables {
+		if !plan.ID.IsUnknown() && existing.ID == plan.ID.ValueString() {
+			continue
+		}
+
+		// table names are not case sensitive
+		if strings.EqualFold(existing.Name, plan.Name.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Duplicate table name",
+				fmt.Sprintf("the workbook already has a table named %s, table names must be unique within the workbook", existing.Name),
+			)
+		}
+
+		if existing.SheetID != plan.SheetID.ValueString() {
+			continue
+		}
+
+		existingRange, err := parseCellRange(existing.Range)
+		if err != nil {
+			continue
+		}
+
+		if tableRange.overlaps(existingRange) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("range"),
+				"Overlapping table",
+				fmt.Sprintf("range %s overlaps table %s at %s in the same sheet", plan.Range.ValueString(), existing.Name, existing.Range),
+			)
+		}
+	}
+
+	names, err := readDefinedNames(r.client, plan.WorkbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading defined names",
+			"Could not read the defined names of workbook "+plan.WorkbookID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// tables share their names with the names scoped to the workbook
+	for _, name := range names {
+		if name.SheetID == nil && strings.EqualFold(name.Name, plan.Name.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Duplicate table name",
+				fmt.Sprintf("the workbook already has a defined name %s, table names can not be the name of a name scoped to the workbook", name.Name),
+			)
+		}
+	}
+}
+
+func (r *excelTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan excelTableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// creates the table with help of the client
+	table, err := createExcelTable(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating table",
+			"Could not create table "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(table)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *excelTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state excelTableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed table from client
+	table, err := readExcelTable(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading table",
+			"Could not read table with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(table)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *excelTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state excelTableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteExcelTable(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting table",
+			"Could not delete table, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the table, renaming it updates the structured references to it
+func (r *excelTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state excelTableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan excelTableResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	table, err := updateExcelTable(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating table",
+			"Could not update table, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(table)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *excelTableResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
@@ -0,0 +1,195 @@
+package terraxcel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func excelTableModel(id, sheetID, name, tableRange string) excelTableResourceModel {
+	model := excelTableResourceModel{
+		ID:          types.StringValue(id),
+		LastUpdated: types.StringValue("Monday, 02-Jan-06 15:04:05 UTC"),
+		WorkbookID:  types.StringValue("wb-1"),
+		SheetID:     types.StringValue(sheetID),
+		Name:        types.StringValue(name),
+		Range:       types.StringValue(tableRange),
+	}
+	if id == "" {
+		model.ID = types.StringUnknown()
+		model.LastUpdated = types.StringUnknown()
+	}
+	return model
+}
+
+func TestExcelTableModifyPlan(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     *excelTableResourceModel
+		plan      excelTableResourceModel
+		tables    []excelTable
+		names     []definedName
+		wantError string
+	}{
+		{
+			name:   "new table",
+			plan:   excelTableModel("", "sheet-1", "Sales", "A1:C10"),
+			tables: []excelTable{{ID: "t-2", SheetID: "sheet-1", Name: "Costs", Range: "E1:G10"}},
+			names:  []definedName{{ID: "n-1", Name: "TaxRate"}},
+		},
+		{
+			name:      "duplicate table name",
+			plan:      excelTableModel("", "sheet-1", "Sales", "A1:C10"),
+			tables:    []excelTable{{ID: "t-2", SheetID: "sheet-2", Name: "SALES", Range: "A1:C10"}},
+			wantError: "Duplicate table name",
+		},
+		{
+			name:      "workbook defined name",
+			plan:      excelTableModel("", "sheet-1", "Sales", "A1:C10"),
+			names:     []definedName{{ID: "n-1", Name: "sales"}},
+			wantError: "Duplicate table name",
+		},
+		{
+			name:  "sheet defined name",
+			plan:  excelTableModel("", "sheet-1", "Sales", "A1:C10"),
+			names: []definedName{{ID: "n-1", SheetID: ptr("sheet-1"), Name: "Sales"}},
+		},
+		{
+			name:      "overlapping table",
+			plan:      excelTableModel("", "sheet-1", "Sales", "A1:C10"),
+			tables:    []excelTable{{ID: "t-2", SheetID: "sheet-1", Name: "Costs", Range: "C5:E20"}},
+			wantError: "Overlapping table",
+		},
+		{
+			name:   "overlapping range in another sheet",
+			plan:   excelTableModel("", "sheet-1", "Sales", "A1:C10"),
+			tables: []excelTable{{ID: "t-2", SheetID: "sheet-2", Name: "Costs", Range: "C5:E20"}},
+		},
+		{
+			name:   "resized table",
+			state:  ptr(excelTableModel("t-1", "sheet-1", "Sales", "A1:C10")),
+			plan:   excelTableModel("t-1", "sheet-1", "Sales", "A1:C20"),
+			tables: []excelTable{{ID: "t-1", SheetID: "sheet-1", Name: "Sales", Range: "A1:C10"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, c := newFakeServer(t)
+			server.handle("GET "+workbookEndpoint("wb-1")+"/table", http.StatusOK, test.tables)
+			server.handle("GET "+workbookEndpoint("wb-1")+"/defined-name", http.StatusOK, test.names)
+
+			r := &excelTableResource{client: c}
+			s := resourceSchema(t, r)
+			req := resource.ModifyPlanRequest{
+				Plan:  newPlan(t, s, test.plan),
+				State: newState(t, s, nil),
+			}
+			if test.state != nil {
+				req.State = newState(t, s, test.state)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if test.wantError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !hasErrorSummary(resp.Diagnostics.Errors(), test.wantError) {
+				t.Fatalf("expected error %q, got %v", test.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestExcelTableValidateConfig(t *testing.T) {
+	column := func(name string) tableColumnModel {
+		return tableColumnModel{Name: types.StringValue(name)}
+	}
+
+	tests := []struct {
+		name      string
+		config    func(*excelTableResourceModel)
+		wantValid bool
+	}{
+		{
+			name:      "header and data row",
+			config:    func(*excelTableResourceModel) {},
+			wantValid: true,
+		},
+		{
+			name: "columns match the range",
+			config: func(m *excelTableResourceModel) {
+				m.Columns = []tableColumnModel{column("Region"), column("Q1"), column("Q2")}
+			},
+			wantValid: true,
+		},
+		{
+			name: "too few columns",
+			config: func(m *excelTableResourceModel) {
+				m.Columns = []tableColumnModel{column("Region"), column("Q1")}
+			},
+		},
+		{
+			name: "duplicate column names",
+			config: func(m *excelTableResourceModel) {
+				m.Columns = []tableColumnModel{column("Region"), column("Q1"), column("q1")}
+			},
+		},
+		{
+			name: "no data row",
+			config: func(m *excelTableResourceModel) {
+				m.Range = types.StringValue("A1:C1")
+			},
+		},
+		{
+			name: "no data row next to the totals",
+			config: func(m *excelTableResourceModel) {
+				m.Range = types.StringValue("A1:C2")
+				m.TotalsRow = types.BoolValue(true)
+			},
+		},
+		{
+			name: "totals without totals row",
+			config: func(m *excelTableResourceModel) {
+				sum := column("Q1")
+				sum.TotalsFunction = types.StringValue("sum")
+				m.Columns = []tableColumnModel{column("Region"), sum, column("Q2")}
+				m.TotalsRow = types.BoolValue(false)
+			},
+		},
+		{
+			name: "custom totals without formula",
+			config: func(m *excelTableResourceModel) {
+				custom := column("Q1")
+				custom.TotalsFunction = types.StringValue("custom")
+				m.Columns = []tableColumnModel{column("Region"), custom, column("Q2")}
+				m.TotalsRow = types.BoolValue(true)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &excelTableResource{}
+			s := resourceSchema(t, r)
+			config := excelTableModel("", "sheet-1", "Sales", "A1:C10")
+			config.ID = types.StringNull()
+			config.LastUpdated = types.StringNull()
+			test.config(&config)
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newConfig(t, s, config)}, resp)
+
+			if valid := !resp.Diagnostics.HasError(); valid != test.wantValid {
+				t.Errorf("valid = %v, want %v: %v", valid, test.wantValid, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -52,7 +52,7 @@ func (d *extensionsDataSource) Schema(_ context.Context, req datasource.SchemaRe
 func (d *extensionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state extensionsDataSourceModel
 
-	extensions, err := d.client.ReadExtensions()
+	extensions, err := readExtensions(d.client)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read extensions",
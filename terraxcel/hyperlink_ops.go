@@ -0,0 +1,56 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// hyperlink links a cell either to a URL, or to a location in the workbook
+// such as Sheet!A1 or a defined name.
+type hyperlink struct {
+	ID         string  `json:"id"`
+	WorkbookID string  `json:"workbook_id"`
+	SheetID    string  `json:"sheet_id"`
+	Cell       string  `json:"cell"`
+	URL        *string `json:"url,omitempty"`
+	Location   *string `json:"location,omitempty"`
+	Display    *string `json:"display,omitempty"`
+	Tooltip    *string `json:"tooltip,omitempty"`
+}
+
+func hyperlinkEndpoint(link *hyperlink) string {
+	return sheetEndpoint(link.WorkbookID, link.SheetID) + "/hyperlink"
+}
+
+func createHyperlink(c *client.Client, link *hyperlink) (*hyperlink, error) {
+	created := &hyperlink{}
+	err := doRequest(c, http.MethodPost, hyperlinkEndpoint(link), link, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readHyperlink(c *client.Client, link *hyperlink) (*hyperlink, error) {
+	read := &hyperlink{}
+	err := doRequest(c, http.MethodGet, hyperlinkEndpoint(link)+"/"+link.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+func updateHyperlink(c *client.Client, link *hyperlink) (*hyperlink, error) {
+	updated := &hyperlink{}
+	err := doRequest(c, http.MethodPut, hyperlinkEndpoint(link)+"/"+link.ID, link, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// deleteHyperlink removes the link, the cell keeps its value.
+func deleteHyperlink(c *client.Client, link *hyperlink) error {
+	return doRequest(c, http.MethodDelete, hyperlinkEndpoint(link)+"/"+link.ID, nil, nil, http.StatusOK)
+}
@@ -0,0 +1,309 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &hyperlinkResource{}
+	_ resource.ResourceWithConfigure      = &hyperlinkResource{}
+	_ resource.ResourceWithValidateConfig = &hyperlinkResource{}
+)
+
+// Limits Excel has for hyperlinks.
+const (
+	maxURLLength     = 2079
+	maxTooltipLength = 255
+)
+
+// hyperlinkSchemes are the schemes of the URLs a cell can link to.
+var hyperlinkSchemes = []string{"http", "https", "mailto", "ftp", "file"}
+
+// NewHyperlinkResource is a helper function to simplify the provider implementation.
+func NewHyperlinkResource() resource.Resource {
+	return &hyperlinkResource{}
+}
+
+type hyperlinkResource struct {
+	client *client.Client
+}
+
+type hyperlinkResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	WorkbookID  types.String `tfsdk:"workbook_id"`
+	SheetID     types.String `tfsdk:"sheet_id"`
+	Cell        types.String `tfsdk:"cell"`
+	URL         types.String `tfsdk:"url"`
+	Location    types.String `tfsdk:"location"`
+	Display     types.String `tfsdk:"display"`
+	Tooltip     types.String `tfsdk:"tooltip"`
+}
+
+func (m *hyperlinkResourceModel) expand() *hyperlink {
+	return &hyperlink{
+		ID:         m.ID.ValueString(),
+		WorkbookID: m.WorkbookID.ValueString(),
+		SheetID:    m.SheetID.ValueString(),
+		Cell:       m.Cell.ValueString(),
+		URL:        m.URL.ValueStringPointer(),
+		Location:   m.Location.ValueStringPointer(),
+		Display:    m.Display.ValueStringPointer(),
+		Tooltip:    m.Tooltip.ValueStringPointer(),
+	}
+}
+
+func (m *hyperlinkResourceModel) flatten(link *hyperlink) {
+	m.ID = types.StringValue(link.ID)
+	m.Cell = types.StringValue(link.Cell)
+	m.URL = types.StringPointerValue(link.URL)
+	m.Location = types.StringPointerValue(link.Location)
+	m.Display = types.StringPointerValue(link.Display)
+	m.Tooltip = types.StringPointerValue(link.Tooltip)
+}
+
+// Metadata returns the resource type name.
+func (r *hyperlinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hyperlink"
+}
+
+// Schema defines the schema for the resource.
+func (r *hyperlinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// a cell has at most one hyperlink
+			"cell": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					cellReference(false),
+				},
+			},
+			// an external link, e.g. https://example.com or mailto:finance@example.com
+			"url": schema.StringAttribute{
+				Optional: true,
+			},
+			// an internal link to a cell like Summary!A1 or a defined name
+			"location": schema.StringAttribute{
+				Optional: true,
+			},
+			// text shown in the cell, replacing its value
+			"display": schema.StringAttribute{
+				Optional: true,
+			},
+			"tooltip": schema.StringAttribute{
+				Optional: true,
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure the hyperlink links to either a URL or a location
+// in the workbook.
+func (r *hyperlinkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config hyperlinkResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invalid := func(attribute, message string) {
+		resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid hyperlink", message)
+	}
+
+	if config.URL.IsNull() == config.Location.IsNull() {
+		invalid("url", "exactly one of url and location must be set")
+	}
+
+	if !config.URL.IsNull() && !config.URL.IsUnknown() {
+		link := config.URL.ValueString()
+		parsed, err := url.Parse(link)
+		if err != nil || !slices.Contains(hyperlinkSchemes, strings.ToLower(parsed.Scheme)) {
+			invalid("url", fmt.Sprintf("%q is not a URL starting with one of %s", link, strings.Join(hyperlinkSchemes, ", ")))
+		}
+		if len(link) > maxURLLength {
+			invalid("url", fmt.Sprintf("url can be at most %d characters long", maxURLLength))
+		}
+	}
+
+	if !config.Location.IsNull() && !config.Location.IsUnknown() {
+		if err := validateSheetReference(config.Location.ValueString()); err != nil {
+			invalid("location", fmt.Sprintf("location must be a cell like Summary!A1 or a defined name: %s", err))
+		}
+	}
+
+	if !config.Tooltip.IsNull() && len([]rune(config.Tooltip.ValueString())) > maxTooltipLength {
+		invalid("tooltip", fmt.Sprintf("tooltip can be at most %d characters long", maxTooltipLength))
+	}
+}
+
+func (r *hyperlinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan hyperlinkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// creates the hyperlink with help of the client
+	link, err := createHyperlink(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating hyperlink",
+			"Could not create hyperlink in cell "+plan.Cell.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(link)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *hyperlinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state hyperlinkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed hyperlink from client
+	link, err := readHyperlink(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading hyperlink",
+			"Could not read hyperlink with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(link)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *hyperlinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state hyperlinkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteHyperlink(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting hyperlink",
+			"Could not delete hyperlink, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the hyperlink
+func (r *hyperlinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state hyperlinkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan hyperlinkResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	link, err := updateHyperlink(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating hyperlink",
+			"Could not update hyperlink, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(link)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *hyperlinkResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
@@ -0,0 +1,68 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// imageFormats maps the extensions of the files that can be embedded to
+// their format.
+var imageFormats = map[string]string{
+	"png":  "png",
+	"jpg":  "jpeg",
+	"jpeg": "jpeg",
+}
+
+// image is a picture placed over the cells of a sheet, its top left corner is
+// at the offset from the top left corner of the anchor cell. Sizes and offsets
+// are in pixels, the content is only sent when the image is created.
+type image struct {
+	ID         string  `json:"id"`
+	WorkbookID string  `json:"workbook_id"`
+	SheetID    string  `json:"sheet_id"`
+	Content    []byte  `json:"content,omitempty"`
+	Format     string  `json:"format,omitempty"`
+	Anchor     string  `json:"anchor"`
+	OffsetX    *int64  `json:"offset_x,omitempty"`
+	OffsetY    *int64  `json:"offset_y,omitempty"`
+	Width      *int64  `json:"width,omitempty"`
+	Height     *int64  `json:"height,omitempty"`
+	AltText    *string `json:"alt_text,omitempty"`
+}
+
+func imageEndpoint(sheetImage *image) string {
+	return sheetEndpoint(sheetImage.WorkbookID, sheetImage.SheetID) + "/image"
+}
+
+func createImage(c *client.Client, sheetImage *image) (*image, error) {
+	created := &image{}
+	err := doRequest(c, http.MethodPost, imageEndpoint(sheetImage), sheetImage, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readImage(c *client.Client, sheetImage *image) (*image, error) {
+	read := &image{}
+	err := doRequest(c, http.MethodGet, imageEndpoint(sheetImage)+"/"+sheetImage.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+// updateImage moves or resizes the image, the content can not be updated.
+func updateImage(c *client.Client, sheetImage *image) (*image, error) {
+	updated := &image{}
+	err := doRequest(c, http.MethodPut, imageEndpoint(sheetImage)+"/"+sheetImage.ID, sheetImage, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deleteImage(c *client.Client, sheetImage *image) error {
+	return doRequest(c, http.MethodDelete, imageEndpoint(sheetImage)+"/"+sheetImage.ID, nil, nil, http.StatusOK)
+}
@@ -0,0 +1,396 @@
+package terraxcel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &imageResource{}
+	_ resource.ResourceWithConfigure      = &imageResource{}
+	_ resource.ResourceWithValidateConfig = &imageResource{}
+	_ resource.ResourceWithModifyPlan     = &imageResource{}
+)
+
+// NewImageResource is a helper function to simplify the provider implementation.
+func NewImageResource() resource.Resource {
+	return &imageResource{}
+}
+
+type imageResource struct {
+	client *client.Client
+}
+
+type imageResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	LastUpdated   types.String `tfsdk:"last_updated"`
+	WorkbookID    types.String `tfsdk:"workbook_id"`
+	SheetID       types.String `tfsdk:"sheet_id"`
+	Source        types.String `tfsdk:"source"`
+	SourceHash    types.String `tfsdk:"source_hash"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	Anchor        types.String `tfsdk:"anchor"`
+	OffsetX       types.Int64  `tfsdk:"offset_x"`
+	OffsetY       types.Int64  `tfsdk:"offset_y"`
+	Width         types.Int64  `tfsdk:"width"`
+	Height        types.Int64  `tfsdk:"height"`
+	AltText       types.String `tfsdk:"alt_text"`
+}
+
+func (m *imageResourceModel) expand() *image {
+	return &image{
+		ID:         m.ID.ValueString(),
+		WorkbookID: m.WorkbookID.ValueString(),
+		SheetID:    m.SheetID.ValueString(),
+		Anchor:     m.Anchor.ValueString(),
+		OffsetX:    m.OffsetX.ValueInt64Pointer(),
+		OffsetY:    m.OffsetY.ValueInt64Pointer(),
+		Width:      knownInt64Pointer(m.Width),
+		Height:     knownInt64Pointer(m.Height),
+		AltText:    m.AltText.ValueStringPointer(),
+	}
+}
+
+// flatten leaves the source as is, the server does not return the content.
+func (m *imageResourceModel) flatten(sheetImage *image) {
+	m.ID = types.StringValue(sheetImage.ID)
+	m.Anchor = types.StringValue(sheetImage.Anchor)
+	m.OffsetX = types.Int64PointerValue(sheetImage.OffsetX)
+	m.OffsetY = types.Int64PointerValue(sheetImage.OffsetY)
+	m.Width = types.Int64PointerValue(sheetImage.Width)
+	m.Height = types.Int64PointerValue(sheetImage.Height)
+	m.AltText = types.StringPointerValue(sheetImage.AltText)
+}
+
+// Metadata returns the resource type name.
+func (r *imageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image"
+}
+
+// Schema defines the schema for the resource.
+func (r *imageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// local png or jpeg file, the image is replaced when the path
+			// or the content of the file changes
+			"source": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_hash": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// sha256 of the uploaded file, set by ModifyPlan
+			"content_sha256": schema.StringAttribute{
+				Computed: true,
+			},
+			// the cell the top left corner of the image is placed in
+			"anchor": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					cellReference(false),
+				},
+			},
+			"offset_x": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64Between(0, 10000),
+				},
+			},
+			"offset_y": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64Between(0, 10000),
+				},
+			},
+			// the size of the image in pixels, defaults to the size of the
+			// file
+			"width": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64Between(1, 10000),
+				},
+			},
+			"height": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64Between(1, 10000),
+				},
+			},
+			// description read by screen readers
+			"alt_text": schema.StringAttribute{
+				Optional: true,
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure the source is an image that can be embedded.
+func (r *imageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config imageResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Source.IsUnknown() {
+		return
+	}
+
+	extension := fileExtension(config.Source.ValueString())
+	if _, ok := imageFormats[extension]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Invalid image",
+			fmt.Sprintf("the source must be a png or jpeg file, got a %q file", extension),
+		)
+	}
+}
+
+// ModifyPlan hashes the source, so the image is replaced when the file
+// changes without its path changing.
+func (r *imageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to hash when the image is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan imageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.Source.IsUnknown() {
+		return
+	}
+
+	// the file may be written by another resource during the apply, the
+	// hash is then known once the image is created
+	content, err := os.ReadFile(plan.Source.ValueString())
+	if err != nil {
+		return
+	}
+	plan.ContentSHA256 = types.StringValue(contentSHA256(content))
+
+	if !req.State.Raw.IsNull() {
+		var state imageResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// images created before the content was hashed only record it
+		if !state.ContentSHA256.IsNull() && !plan.ContentSHA256.Equal(state.ContentSHA256) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_sha256"))
+		}
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), plan.ContentSHA256)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan imageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// reads the image before creating anything
+	content, err := os.ReadFile(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading image source",
+			"Could not read "+plan.Source.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	sheetImage := plan.expand()
+	sheetImage.Content = content
+	plan.ContentSHA256 = types.StringValue(contentSHA256(content))
+	sheetImage.Format = imageFormats[fileExtension(plan.Source.ValueString())]
+
+	// creates the image with help of the client
+	sheetImage, err = createImage(r.client, sheetImage)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating image",
+			"Could not place image "+plan.Source.ValueString()+" at "+plan.Anchor.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(sheetImage)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state imageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed image from client
+	sheetImage, err := readImage(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading image",
+			"Could not read image with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(sheetImage)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state imageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteImage(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting image",
+			"Could not delete image, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update moves or resizes the image, a new source replaces it
+func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state imageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan imageResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	if plan.ContentSHA256.IsUnknown() {
+		plan.ContentSHA256 = state.ContentSHA256
+	}
+	sheetImage, err := updateImage(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating image",
+			"Could not update image, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(sheetImage)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// contentSHA256 returns the hex encoded sha256 of the content.
+func contentSHA256(content []byte) string {
+	checksum := sha256.Sum256(content)
+	return hex.EncodeToString(checksum[:])
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *imageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
@@ -0,0 +1,153 @@
+package terraxcel
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestImageModifyPlan(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(source, []byte("logo"), 0o600); err != nil {
+		t.Fatalf("could not write image: %s", err)
+	}
+	hash := contentSHA256([]byte("logo"))
+
+	tests := []struct {
+		name        string
+		source      string
+		state       types.String
+		created     bool
+		want        types.String
+		wantReplace bool
+	}{
+		{name: "new image", source: source, want: types.StringValue(hash)},
+		{name: "file unchanged", source: source, state: types.StringValue(hash), created: true, want: types.StringValue(hash)},
+		{name: "file changed", source: source, state: types.StringValue(contentSHA256([]byte("old logo"))), created: true, want: types.StringValue(hash), wantReplace: true},
+		{name: "created before hashing", source: source, state: types.StringNull(), created: true, want: types.StringValue(hash)},
+		{name: "file written during apply", source: filepath.Join(t.TempDir(), "chart.png"), want: types.StringUnknown()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &imageResource{}
+			s := resourceSchema(t, r)
+
+			model := imageResourceModel{
+				ID:            types.StringUnknown(),
+				LastUpdated:   types.StringUnknown(),
+				WorkbookID:    types.StringValue("wb-1"),
+				SheetID:       types.StringValue("sheet-1"),
+				Source:        types.StringValue(test.source),
+				SourceHash:    types.StringNull(),
+				ContentSHA256: types.StringUnknown(),
+				Anchor:        types.StringValue("F1"),
+				OffsetX:       types.Int64Value(0),
+				OffsetY:       types.Int64Value(0),
+				Width:         types.Int64Unknown(),
+				Height:        types.Int64Unknown(),
+				AltText:       types.StringNull(),
+			}
+			req := resource.ModifyPlanRequest{
+				Plan:  newPlan(t, s, model),
+				State: newState(t, s, nil),
+			}
+			if test.created {
+				existing := model
+				existing.ID = types.StringValue("image-1")
+				existing.LastUpdated = types.StringValue("yesterday")
+				existing.ContentSHA256 = test.state
+				existing.Width = types.Int64Value(240)
+				existing.Height = types.Int64Value(80)
+				req.State = newState(t, s, existing)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			var plan imageResourceModel
+			resp.Plan.Get(context.Background(), &plan)
+			if !plan.ContentSHA256.Equal(test.want) {
+				t.Errorf("content_sha256 = %s, want %s", plan.ContentSHA256, test.want)
+			}
+			if replace := len(resp.RequiresReplace) > 0; replace != test.wantReplace {
+				t.Errorf("requires replace = %v, want %v", replace, test.wantReplace)
+			}
+		})
+	}
+}
+
+func TestImageCreateSize(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(source, []byte("logo"), 0o600); err != nil {
+		t.Fatalf("could not write image: %s", err)
+	}
+
+	tests := []struct {
+		name   string
+		width  types.Int64
+		height types.Int64
+		want   *int64
+	}{
+		{name: "size of the file", width: types.Int64Unknown(), height: types.Int64Unknown()},
+		{name: "configured size", width: types.Int64Value(240), height: types.Int64Value(240), want: ptr(int64(240))},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, c := newFakeServer(t)
+			endpoint := sheetEndpoint("wb-1", "sheet-1") + "/image"
+			server.handle("POST "+endpoint, http.StatusCreated, image{
+				ID:      "image-1",
+				Anchor:  "F1",
+				OffsetX: ptr(int64(0)),
+				OffsetY: ptr(int64(0)),
+				Width:   ptr(int64(240)),
+				Height:  ptr(int64(240)),
+			})
+
+			r := &imageResource{client: c}
+			s := resourceSchema(t, r)
+			plan := imageResourceModel{
+				ID:            types.StringUnknown(),
+				LastUpdated:   types.StringUnknown(),
+				WorkbookID:    types.StringValue("wb-1"),
+				SheetID:       types.StringValue("sheet-1"),
+				Source:        types.StringValue(source),
+				ContentSHA256: types.StringValue(contentSHA256([]byte("logo"))),
+				Anchor:        types.StringValue("F1"),
+				OffsetX:       types.Int64Value(0),
+				OffsetY:       types.Int64Value(0),
+				Width:         test.width,
+				Height:        test.height,
+			}
+
+			resp := &resource.CreateResponse{State: newState(t, s, nil)}
+			r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, plan)}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var sent map[string]interface{}
+			server.decodeBody(t, "POST "+endpoint, &sent)
+			for _, attribute := range []string{"width", "height"} {
+				got, ok := sent[attribute]
+				if test.want == nil && ok {
+					t.Errorf("sent %s %v, the server uses the size of the file when it is not set", attribute, got)
+				}
+				if test.want != nil && got != float64(*test.want) {
+					t.Errorf("sent %s %v, want %d", attribute, got, *test.want)
+				}
+			}
+		})
+	}
+}
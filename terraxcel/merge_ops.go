@@ -0,0 +1,78 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// merge is a range of cells merged into one, keeping the value of the top
+// left cell.
+type merge struct {
+	ID         string `json:"id"`
+	WorkbookID string `json:"workbook_id"`
+	SheetID    string `json:"sheet_id"`
+	Range      string `json:"range"`
+}
+
+func mergeEndpoint(workbookID, sheetID string) string {
+	return sheetEndpoint(workbookID, sheetID) + "/merge"
+}
+
+func createMerge(c *client.Client, cellMerge *merge) (*merge, error) {
+	created := &merge{}
+	err := doRequest(c, http.MethodPost, mergeEndpoint(cellMerge.WorkbookID, cellMerge.SheetID), cellMerge, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readMerge(c *client.Client, cellMerge *merge) (*merge, error) {
+	read := &merge{}
+	err := doRequest(c, http.MethodGet, mergeEndpoint(cellMerge.WorkbookID, cellMerge.SheetID)+"/"+cellMerge.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+// readMerges returns all merged ranges in the sheet.
+func readMerges(c *client.Client, workbookID, sheetID string) ([]merge, error) {
+	var merges []merge
+	err := doRequest(c, http.MethodGet, mergeEndpoint(workbookID, sheetID), nil, &merges, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return merges, nil
+}
+
+// overlappingMerges returns the merged ranges of the sheet that overlap
+// mergeRange, except the merge with the ID ignoreID.
+func overlappingMerges(c *client.Client, workbookID, sheetID string, mergeRange cellRange, ignoreID string) ([]string, error) {
+	merges, err := readMerges(c, workbookID, sheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var overlapping []string
+	for _, existing := range merges {
+		if existing.ID == ignoreID {
+			continue
+		}
+		existingRange, err := parseCellRange(existing.Range)
+		if err != nil {
+			continue
+		}
+		if mergeRange.overlaps(existingRange) {
+			overlapping = append(overlapping, existing.Range)
+		}
+	}
+	return overlapping, nil
+}
+
+// deleteMerge unmerges the range, values cleared by the merge are not
+// restored.
+func deleteMerge(c *client.Client, cellMerge *merge) error {
+	return doRequest(c, http.MethodDelete, mergeEndpoint(cellMerge.WorkbookID, cellMerge.SheetID)+"/"+cellMerge.ID, nil, nil, http.StatusOK)
+}
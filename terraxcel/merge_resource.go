@@ -0,0 +1,336 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &mergeResource{}
+	_ resource.ResourceWithConfigure      = &mergeResource{}
+	_ resource.ResourceWithModifyPlan     = &mergeResource{}
+	_ resource.ResourceWithValidateConfig = &mergeResource{}
+)
+
+// NewMergeResource is a helper function to simplify the provider implementation.
+func NewMergeResource() resource.Resource {
+	return &mergeResource{}
+}
+
+type mergeResource struct {
+	client *client.Client
+}
+
+type mergeResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	WorkbookID  types.String `tfsdk:"workbook_id"`
+	SheetID     types.String `tfsdk:"sheet_id"`
+	Range       types.String `tfsdk:"range"`
+	Anchor      types.String `tfsdk:"anchor"`
+}
+
+// Metadata returns the resource type name.
+func (r *mergeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_merge"
+}
+
+// Schema defines the schema for the resource.
+func (r *mergeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"range": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					cellReference(true),
+				},
+			},
+			// the top left cell, the only cell keeping its value
+			"anchor": schema.StringAttribute{
+				Computed: true,
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure the range spans more than one cell.
+func (r *mergeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config mergeResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Range.IsUnknown() {
+		return
+	}
+
+	mergeRange, err := parseCellRange(config.Range.ValueString())
+	if err != nil {
+		// reported by the validator of the attribute
+		return
+	}
+
+	if mergeRange.FirstColumn == mergeRange.LastColumn && mergeRange.FirstRow == mergeRange.LastRow {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("range"),
+			"Invalid merge",
+			fmt.Sprintf("range %s is a single cell, a merge needs at least two cells", config.Range.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan makes sure a new or moved merge does not overlap the merges
+// already in the sheet, and warns that the values of the other cells are
+// cleared. Merges created in the same apply are not in the sheet yet, overlaps
+// between them are reported by Create.
+func (r *mergeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check when the merge is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan mergeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// existing merges are only checked when they are replaced, the merge
+	// itself is then removed before its new range is merged
+	var replacedID string
+	if !req.State.Raw.IsNull() {
+		var state mergeResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Range.Equal(state.Range) && plan.SheetID.Equal(state.SheetID) && plan.WorkbookID.Equal(state.WorkbookID) {
+			return
+		}
+		replacedID = state.ID.ValueString()
+	}
+
+	if plan.Range.IsUnknown() {
+		return
+	}
+
+	mergeRange, err := parseCellRange(plan.Range.ValueString())
+	if err != nil {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("anchor"), mergeRange.anchor())
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("range"),
+		"Values of merged cells are cleared",
+		fmt.Sprintf("Only the value of %s is kept when merging %s, the values of the other cells are cleared and not restored when the cells are unmerged.", mergeRange.anchor(), plan.Range.ValueString()),
+	)
+
+	// the sheet may not exist yet, or the provider not be configured
+	if r.client == nil || plan.WorkbookID.IsUnknown() || plan.SheetID.IsUnknown() {
+		return
+	}
+
+	overlapping, err := overlappingMerges(r.client, plan.WorkbookID.ValueString(), plan.SheetID.ValueString(), mergeRange, replacedID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading merges",
+			"Could not read the merged cells of sheet "+plan.SheetID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, existing := range overlapping {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("range"),
+			"Overlapping merge",
+			fmt.Sprintf("range %s overlaps the merged range %s in the same sheet", plan.Range.ValueString(), existing),
+		)
+	}
+}
+
+func (r *mergeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan mergeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// merges the cells with help of the client
+	cellMerge, err := createMerge(r.client, &merge{
+		WorkbookID: plan.WorkbookID.ValueString(),
+		SheetID:    plan.SheetID.ValueString(),
+		Range:      plan.Range.ValueString(),
+	})
+	if err != nil {
+		// a merge created in the same apply may overlap, as it was not in
+		// the sheet when planning
+		if mergeRange, parseErr := parseCellRange(plan.Range.ValueString()); parseErr == nil {
+			overlapping, readErr := overlappingMerges(r.client, plan.WorkbookID.ValueString(), plan.SheetID.ValueString(), mergeRange, "")
+			if readErr == nil && len(overlapping) > 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("range"),
+					"Overlapping merge",
+					fmt.Sprintf("Could not merge range %s, it overlaps the merged range %s in the same sheet: %s", plan.Range.ValueString(), strings.Join(overlapping, ", "), err),
+				)
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating merge",
+			"Could not merge range "+plan.Range.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mergeRange, err := parseCellRange(cellMerge.Range)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating merge",
+			"Could not parse merged range "+cellMerge.Range+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.ID = types.StringValue(cellMerge.ID)
+	plan.Range = types.StringValue(cellMerge.Range)
+	plan.Anchor = types.StringValue(mergeRange.anchor())
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *mergeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state mergeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed merge from client
+	cellMerge, err := readMerge(r.client, &merge{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+		SheetID:    state.SheetID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading merge",
+			"Could not read merge with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = types.StringValue(cellMerge.ID)
+	state.Range = types.StringValue(cellMerge.Range)
+	if mergeRange, err := parseCellRange(cellMerge.Range); err == nil {
+		state.Anchor = types.StringValue(mergeRange.anchor())
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete unmerges the cells and removes the Terraform state on success.
+func (r *mergeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state mergeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteMerge(r.client, &merge{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+		SheetID:    state.SheetID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting merge",
+			"Could not unmerge cells, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Update is never called, all attributes of a merge require a replacement.
+func (r *mergeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan mergeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mergeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
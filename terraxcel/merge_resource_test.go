@@ -0,0 +1,117 @@
+package terraxcel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func mergeModel(id, mergeRange string) mergeResourceModel {
+	model := mergeResourceModel{
+		ID:          types.StringValue(id),
+		LastUpdated: types.StringValue("Monday, 02-Jan-06 15:04:05 UTC"),
+		WorkbookID:  types.StringValue("wb-1"),
+		SheetID:     types.StringValue("sheet-1"),
+		Range:       types.StringValue(mergeRange),
+		Anchor:      types.StringValue("A1"),
+	}
+	if id == "" {
+		model.ID = types.StringUnknown()
+		model.LastUpdated = types.StringUnknown()
+		model.Anchor = types.StringUnknown()
+	}
+	return model
+}
+
+func TestMergeModifyPlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       *mergeResourceModel
+		plan        mergeResourceModel
+		merges      []merge
+		wantOverlap bool
+		wantRead    bool
+	}{
+		{
+			name:     "new merge",
+			plan:     mergeModel("", "A1:D1"),
+			merges:   []merge{{ID: "m-2", Range: "A3:D3"}},
+			wantRead: true,
+		},
+		{
+			name:        "new merge overlapping",
+			plan:        mergeModel("", "B1:C1"),
+			merges:      []merge{{ID: "m-2", Range: "A1:B2"}},
+			wantOverlap: true,
+			wantRead:    true,
+		},
+		{
+			name:   "unchanged merge",
+			state:  ptr(mergeModel("m-1", "A1:B1")),
+			plan:   mergeModel("m-1", "A1:B1"),
+			merges: []merge{{ID: "m-1", Range: "A1:B1"}},
+		},
+		{
+			name:     "moved merge overlapping its old range",
+			state:    ptr(mergeModel("m-1", "A1:B1")),
+			plan:     mergeModel("", "A1:C1"),
+			merges:   []merge{{ID: "m-1", Range: "A1:B1"}},
+			wantRead: true,
+		},
+		{
+			name:        "moved merge overlapping another merge",
+			state:       ptr(mergeModel("m-1", "A1:B1")),
+			plan:        mergeModel("", "A1:C1"),
+			merges:      []merge{{ID: "m-1", Range: "A1:B1"}, {ID: "m-2", Range: "C1:D1"}},
+			wantOverlap: true,
+			wantRead:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, c := newFakeServer(t)
+			server.handle("GET "+mergeEndpoint("wb-1", "sheet-1"), http.StatusOK, test.merges)
+
+			r := &mergeResource{client: c}
+			s := resourceSchema(t, r)
+			req := resource.ModifyPlanRequest{
+				State: newState(t, s, test.state),
+				Plan:  newPlan(t, s, test.plan),
+			}
+			if test.state == nil {
+				req.State = newState(t, s, nil)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if got := hasErrorSummary(resp.Diagnostics.Errors(), "Overlapping merge"); got != test.wantOverlap {
+				t.Errorf("overlap = %v, want %v: %v", got, test.wantOverlap, resp.Diagnostics)
+			}
+			if got := server.received("GET " + mergeEndpoint("wb-1", "sheet-1")); got != test.wantRead {
+				t.Errorf("read merges = %v, want %v", got, test.wantRead)
+			}
+		})
+	}
+}
+
+// TestMergeCreateOverlap covers two overlapping merges created in the same
+// apply, which pass the plan as neither is in the sheet yet.
+func TestMergeCreateOverlap(t *testing.T) {
+	server, c := newFakeServer(t)
+	server.handle("POST "+mergeEndpoint("wb-1", "sheet-1"), http.StatusConflict, nil)
+	server.handle("GET "+mergeEndpoint("wb-1", "sheet-1"), http.StatusOK, []merge{{ID: "m-2", Range: "A1:B2"}})
+
+	r := &mergeResource{client: c}
+	s := resourceSchema(t, r)
+	resp := &resource.CreateResponse{State: newState(t, s, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, mergeModel("", "B1:C1"))}, resp)
+
+	if !hasErrorSummary(resp.Diagnostics.Errors(), "Overlapping merge") {
+		t.Fatalf("expected an overlapping merge error, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,250 @@
+package terraxcel
+
+import (
+	"context"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &namedStyleResource{}
+	_ resource.ResourceWithConfigure = &namedStyleResource{}
+)
+
+// NewNamedStyleResource is a helper function to simplify the provider implementation.
+func NewNamedStyleResource() resource.Resource {
+	return &namedStyleResource{}
+}
+
+type namedStyleResource struct {
+	client *client.Client
+}
+
+type namedStyleResourceModel struct {
+	ID           types.String     `tfsdk:"id"`
+	LastUpdated  types.String     `tfsdk:"last_updated"`
+	WorkbookID   types.String     `tfsdk:"workbook_id"`
+	Name         types.String     `tfsdk:"name"`
+	Font         *fontModel       `tfsdk:"font"`
+	Fill         *fillModel       `tfsdk:"fill"`
+	Borders      []borderModel    `tfsdk:"borders"`
+	Alignment    *alignmentModel  `tfsdk:"alignment"`
+	NumberFormat types.String     `tfsdk:"number_format"`
+	Protection   *protectionModel `tfsdk:"protection"`
+}
+
+func (m *namedStyleResourceModel) style() styleModel {
+	return styleModel{
+		Font:         m.Font,
+		Fill:         m.Fill,
+		Borders:      m.Borders,
+		Alignment:    m.Alignment,
+		NumberFormat: m.NumberFormat,
+		Protection:   m.Protection,
+	}
+}
+
+func (m *namedStyleResourceModel) setStyle(s styleModel) {
+	m.Font = s.Font
+	m.Fill = s.Fill
+	m.Borders = s.Borders
+	m.Alignment = s.Alignment
+	m.NumberFormat = s.NumberFormat
+	m.Protection = s.Protection
+}
+
+// Metadata returns the resource type name.
+func (r *namedStyleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_named_style"
+}
+
+// Schema defines the schema for the resource.
+func (r *namedStyleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := styleAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["workbook_id"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	// cell styles refer to the named style by its name
+	attributes["name"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["last_updated"] = schema.StringAttribute{
+		Computed: true,
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (r *namedStyleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan namedStyleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// creates the named style with help of the client
+	workbookStyle, err := createNamedStyle(r.client, &namedStyle{
+		WorkbookID: plan.WorkbookID.ValueString(),
+		Name:       plan.Name.ValueString(),
+		Style:      plan.style().expand(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating named style",
+			"Could not create named style "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.ID = types.StringValue(workbookStyle.ID)
+	plan.Name = types.StringValue(workbookStyle.Name)
+	plan.setStyle(flattenStyle(workbookStyle.Style))
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *namedStyleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state namedStyleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed named style from client
+	workbookStyle, err := readNamedStyle(r.client, &namedStyle{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading named style",
+			"Could not read named style with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = types.StringValue(workbookStyle.ID)
+	state.Name = types.StringValue(workbookStyle.Name)
+	state.setStyle(flattenStyle(workbookStyle.Style))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *namedStyleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state namedStyleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteNamedStyle(r.client, &namedStyle{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting named style",
+			"Could not delete named style, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Update changes the named style, the server applies the change to all cells
+// using it.
+func (r *namedStyleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state namedStyleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan namedStyleResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workbookStyle, err := updateNamedStyle(r.client, &namedStyle{
+		ID:         state.ID.ValueString(),
+		WorkbookID: state.WorkbookID.ValueString(),
+		Name:       plan.Name.ValueString(),
+		Style:      plan.style().expand(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating named style",
+			"Could not update named style, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.ID = types.StringValue(workbookStyle.ID)
+	plan.Name = types.StringValue(workbookStyle.Name)
+	plan.setStyle(flattenStyle(workbookStyle.Style))
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *namedStyleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
@@ -0,0 +1,82 @@
+package terraxcel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNamedStyleRoundTrip(t *testing.T) {
+	server, c := newFakeServer(t)
+	endpoint := workbookEndpoint("wb-1") + "/named-style"
+
+	// the server stores the style as it is sent
+	var stored namedStyle
+	server.handleFunc("POST "+endpoint, func(body []byte) (int, interface{}) {
+		if err := json.Unmarshal(body, &stored); err != nil {
+			return http.StatusBadRequest, nil
+		}
+		stored.ID = "style-1"
+		return http.StatusCreated, stored
+	})
+	server.handleFunc("GET "+endpoint+"/style-1", func([]byte) (int, interface{}) {
+		return http.StatusOK, stored
+	})
+
+	r := &namedStyleResource{client: c}
+	s := resourceSchema(t, r)
+	plan := namedStyleResourceModel{
+		ID:          types.StringUnknown(),
+		LastUpdated: types.StringUnknown(),
+		WorkbookID:  types.StringValue("wb-1"),
+		Name:        types.StringValue("Currency"),
+		Font: &fontModel{
+			Name:          types.StringValue("Calibri"),
+			Size:          types.Float64Value(11),
+			Bold:          types.BoolValue(true),
+			Italic:        types.BoolNull(),
+			Underline:     types.StringNull(),
+			Strikethrough: types.BoolNull(),
+			Color:         types.StringValue("1F3864"),
+		},
+		Borders: []borderModel{
+			{Position: types.StringValue("bottom"), Style: types.StringValue("double"), Color: types.StringNull()},
+		},
+		NumberFormat: types.StringValue("#,##0.00 [$EUR]"),
+	}
+
+	createResp := &resource.CreateResponse{State: newState(t, s, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", createResp.Diagnostics)
+	}
+
+	if stored.Name != "Currency" || stored.Style.Font == nil || stored.Style.Font.Bold == nil || !*stored.Style.Font.Bold {
+		t.Errorf("sent %+v, want the bold Currency style", stored)
+	}
+	if stored.Style.Font != nil && stored.Style.Font.Italic != nil {
+		t.Errorf("sent italic %v, unset attributes must be left as the default", *stored.Style.Font.Italic)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(context.Background(), resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+
+	var state namedStyleResourceModel
+	readResp.State.Get(context.Background(), &state)
+	if !state.ID.Equal(types.StringValue("style-1")) {
+		t.Errorf("id = %s, want style-1", state.ID)
+	}
+	plan.ID = state.ID
+	plan.LastUpdated = state.LastUpdated
+	if !reflect.DeepEqual(state, plan) {
+		t.Errorf("state after refresh = %+v, want the planned style %+v", state, plan)
+	}
+}
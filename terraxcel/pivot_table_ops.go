@@ -0,0 +1,65 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// pivotTable summarizes a source range or table, the fields are the names of
+// the columns in the header row of the source.
+type pivotTable struct {
+	ID           string            `json:"id"`
+	WorkbookID   string            `json:"workbook_id"`
+	SheetID      string            `json:"sheet_id"`
+	Name         string            `json:"name"`
+	Source       string            `json:"source"`
+	Location     string            `json:"location"`
+	RowFields    []string          `json:"row_fields,omitempty"`
+	ColumnFields []string          `json:"column_fields,omitempty"`
+	FilterFields []string          `json:"filter_fields,omitempty"`
+	ValueFields  []pivotValueField `json:"value_fields"`
+}
+
+// pivotValueField aggregates the values of a field with a function like sum.
+type pivotValueField struct {
+	Field        string  `json:"field"`
+	Function     string  `json:"function"`
+	Name         *string `json:"name,omitempty"`
+	NumberFormat *string `json:"number_format,omitempty"`
+}
+
+func pivotTableEndpoint(pivot *pivotTable) string {
+	return sheetEndpoint(pivot.WorkbookID, pivot.SheetID) + "/pivot-table"
+}
+
+func createPivotTable(c *client.Client, pivot *pivotTable) (*pivotTable, error) {
+	created := &pivotTable{}
+	err := doRequest(c, http.MethodPost, pivotTableEndpoint(pivot), pivot, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readPivotTable(c *client.Client, pivot *pivotTable) (*pivotTable, error) {
+	read := &pivotTable{}
+	err := doRequest(c, http.MethodGet, pivotTableEndpoint(pivot)+"/"+pivot.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+func updatePivotTable(c *client.Client, pivot *pivotTable) (*pivotTable, error) {
+	updated := &pivotTable{}
+	err := doRequest(c, http.MethodPut, pivotTableEndpoint(pivot)+"/"+pivot.ID, pivot, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deletePivotTable(c *client.Client, pivot *pivotTable) error {
+	return doRequest(c, http.MethodDelete, pivotTableEndpoint(pivot)+"/"+pivot.ID, nil, nil, http.StatusOK)
+}
@@ -0,0 +1,370 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &pivotTableResource{}
+	_ resource.ResourceWithConfigure      = &pivotTableResource{}
+	_ resource.ResourceWithValidateConfig = &pivotTableResource{}
+)
+
+// NewPivotTableResource is a helper function to simplify the provider implementation.
+func NewPivotTableResource() resource.Resource {
+	return &pivotTableResource{}
+}
+
+type pivotTableResource struct {
+	client *client.Client
+}
+
+type pivotTableResourceModel struct {
+	ID           types.String           `tfsdk:"id"`
+	LastUpdated  types.String           `tfsdk:"last_updated"`
+	WorkbookID   types.String           `tfsdk:"workbook_id"`
+	SheetID      types.String           `tfsdk:"sheet_id"`
+	Name         types.String           `tfsdk:"name"`
+	Source       types.String           `tfsdk:"source"`
+	Location     types.String           `tfsdk:"location"`
+	RowFields    []types.String         `tfsdk:"row_fields"`
+	ColumnFields []types.String         `tfsdk:"column_fields"`
+	FilterFields []types.String         `tfsdk:"filter_fields"`
+	ValueFields  []pivotValueFieldModel `tfsdk:"value_fields"`
+}
+
+type pivotValueFieldModel struct {
+	Field        types.String `tfsdk:"field"`
+	Function     types.String `tfsdk:"function"`
+	Name         types.String `tfsdk:"name"`
+	NumberFormat types.String `tfsdk:"number_format"`
+}
+
+func (m *pivotTableResourceModel) expand() *pivotTable {
+	pivot := &pivotTable{
+		ID:           m.ID.ValueString(),
+		WorkbookID:   m.WorkbookID.ValueString(),
+		SheetID:      m.SheetID.ValueString(),
+		Name:         m.Name.ValueString(),
+		Source:       m.Source.ValueString(),
+		Location:     m.Location.ValueString(),
+		RowFields:    expandStrings(m.RowFields),
+		ColumnFields: expandStrings(m.ColumnFields),
+		FilterFields: expandStrings(m.FilterFields),
+	}
+
+	for _, value := range m.ValueFields {
+		pivot.ValueFields = append(pivot.ValueFields, pivotValueField{
+			Field:        value.Field.ValueString(),
+			Function:     value.Function.ValueString(),
+			Name:         value.Name.ValueStringPointer(),
+			NumberFormat: value.NumberFormat.ValueStringPointer(),
+		})
+	}
+
+	return pivot
+}
+
+func (m *pivotTableResourceModel) flatten(pivot *pivotTable) {
+	m.ID = types.StringValue(pivot.ID)
+	m.Name = types.StringValue(pivot.Name)
+	m.Source = types.StringValue(pivot.Source)
+	m.Location = types.StringValue(pivot.Location)
+	m.RowFields = flattenStrings(pivot.RowFields)
+	m.ColumnFields = flattenStrings(pivot.ColumnFields)
+	m.FilterFields = flattenStrings(pivot.FilterFields)
+
+	m.ValueFields = nil
+	for _, value := range pivot.ValueFields {
+		m.ValueFields = append(m.ValueFields, pivotValueFieldModel{
+			Field:        types.StringValue(value.Field),
+			Function:     types.StringValue(value.Function),
+			Name:         types.StringPointerValue(value.Name),
+			NumberFormat: types.StringPointerValue(value.NumberFormat),
+		})
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *pivotTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pivot_table"
+}
+
+// Schema defines the schema for the resource.
+func (r *pivotTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// the sheet the pivot table is placed in
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					excelName(),
+				},
+			},
+			// range with a header row, e.g. Data!$A$1:$D$100, or the name of
+			// a table
+			"source": schema.StringAttribute{
+				Required: true,
+			},
+			// the cell the top left corner of the pivot table is placed in
+			"location": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					cellReference(false),
+				},
+			},
+			"row_fields": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"column_fields": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_fields": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"value_fields": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Required: true,
+						},
+						"function": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("sum"),
+							Validators: []validator.String{
+								stringOneOf("sum", "count", "average", "max", "min", "product",
+									"count_numbers", "stddev", "stddevp", "var", "varp"),
+							},
+						},
+						// caption of the field, e.g. Total revenue
+						"name": schema.StringAttribute{
+							Optional: true,
+						},
+						"number_format": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure the source is a range or table, and that every
+// field has a single place in the pivot table.
+func (r *pivotTableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config pivotTableResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invalid := func(attribute path.Path, message string) {
+		resp.Diagnostics.AddAttributeError(attribute, "Invalid pivot table", message)
+	}
+
+	if !config.Source.IsNull() && !config.Source.IsUnknown() {
+		if err := validateSheetReference(config.Source.ValueString()); err != nil {
+			invalid(path.Root("source"), fmt.Sprintf("source must be a range like Data!$A$1:$D$100 or the name of a table: %s", err))
+		}
+	}
+
+	if len(config.ValueFields) == 0 {
+		invalid(path.Root("value_fields"), "a pivot table requires at least one value field")
+	}
+
+	// a field is either a row, a column or a filter
+	placed := map[string]string{}
+	for _, placement := range []struct {
+		attribute string
+		fields    []types.String
+	}{
+		{"row_fields", config.RowFields},
+		{"column_fields", config.ColumnFields},
+		{"filter_fields", config.FilterFields},
+	} {
+		attribute, fields := placement.attribute, placement.fields
+		for i, field := range fields {
+			if field.IsNull() || field.IsUnknown() {
+				continue
+			}
+			if previous, ok := placed[field.ValueString()]; ok {
+				invalid(path.Root(attribute).AtListIndex(i), fmt.Sprintf("field %q is already used in %s", field.ValueString(), previous))
+				continue
+			}
+			placed[field.ValueString()] = attribute
+		}
+	}
+}
+
+func (r *pivotTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan pivotTableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// creates the pivot table with help of the client
+	pivot, err := createPivotTable(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating pivot table",
+			"Could not create pivot table "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(pivot)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *pivotTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state pivotTableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed pivot table from client
+	pivot, err := readPivotTable(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading pivot table",
+			"Could not read pivot table with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(pivot)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *pivotTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state pivotTableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deletePivotTable(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting pivot table",
+			"Could not delete pivot table, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the pivot table, the server refreshes it from the source
+func (r *pivotTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state pivotTableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan pivotTableResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	pivot, err := updatePivotTable(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating pivot table",
+			"Could not update pivot table, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(pivot)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *pivotTableResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
@@ -0,0 +1,115 @@
+package terraxcel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPivotTableValidateConfig(t *testing.T) {
+	fields := func(names ...string) []types.String {
+		var values []types.String
+		for _, name := range names {
+			values = append(values, types.StringValue(name))
+		}
+		return values
+	}
+	sum := []pivotValueFieldModel{{Field: types.StringValue("Revenue"), Function: types.StringValue("sum")}}
+
+	tests := []struct {
+		name         string
+		source       types.String
+		rowFields    []types.String
+		columnFields []types.String
+		filterFields []types.String
+		valueFields  []pivotValueFieldModel
+		valid        bool
+	}{
+		{
+			name:         "range",
+			source:       types.StringValue("Data!$A$1:$D$100"),
+			rowFields:    fields("Region"),
+			columnFields: fields("Quarter"),
+			filterFields: fields("Year"),
+			valueFields:  sum,
+			valid:        true,
+		},
+		{
+			name:        "table",
+			source:      types.StringValue("SalesTable"),
+			rowFields:   fields("Region"),
+			valueFields: sum,
+			valid:       true,
+		},
+		{
+			name:        "unknown source",
+			source:      types.StringUnknown(),
+			valueFields: sum,
+			valid:       true,
+		},
+		{
+			name:        "range without a sheet",
+			source:      types.StringValue("A1:D100"),
+			valueFields: sum,
+		},
+		{
+			name:      "no value fields",
+			source:    types.StringValue("Data!$A$1:$D$100"),
+			rowFields: fields("Region"),
+		},
+		{
+			name:         "field in rows and columns",
+			source:       types.StringValue("Data!$A$1:$D$100"),
+			rowFields:    fields("Region"),
+			columnFields: fields("Region"),
+			valueFields:  sum,
+		},
+		{
+			name:         "field in rows and filters",
+			source:       types.StringValue("Data!$A$1:$D$100"),
+			rowFields:    fields("Region", "Quarter"),
+			filterFields: fields("Quarter"),
+			valueFields:  sum,
+		},
+		{
+			name:        "field twice in rows",
+			source:      types.StringValue("Data!$A$1:$D$100"),
+			rowFields:   fields("Region", "Region"),
+			valueFields: sum,
+		},
+		{
+			name:        "unknown fields",
+			source:      types.StringValue("Data!$A$1:$D$100"),
+			rowFields:   []types.String{types.StringUnknown(), types.StringUnknown()},
+			valueFields: sum,
+			valid:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &pivotTableResource{}
+			s := resourceSchema(t, r)
+			config := pivotTableResourceModel{
+				WorkbookID:   types.StringValue("wb-1"),
+				SheetID:      types.StringValue("sheet-1"),
+				Name:         types.StringValue("SalesPivot"),
+				Source:       test.source,
+				Location:     types.StringValue("A3"),
+				RowFields:    test.rowFields,
+				ColumnFields: test.columnFields,
+				FilterFields: test.filterFields,
+				ValueFields:  test.valueFields,
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newConfig(t, s, config)}, resp)
+
+			if valid := !resp.Diagnostics.HasError(); valid != test.valid {
+				t.Errorf("valid = %v, want %v: %v", valid, test.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -119,11 +119,30 @@ func (p *terraxcelProvider) Configure(ctx context.Context, req provider.Configur
 func (p *terraxcelProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewExtensionsDataSource,
+		NewWorkbookExportDataSource,
+		NewDefinedNamesDataSource,
 	}
 }
 
 func (p *terraxcelProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewWorkbookResource,
+		NewSheetResource,
+		NewCellResource,
+		NewCellStyleResource,
+		NewNamedStyleResource,
+		NewConditionalFormatResource,
+		NewDataValidationResource,
+		NewMergeResource,
+		NewColumnResource,
+		NewRowResource,
+		NewExcelTableResource,
+		NewAutofilterResource,
+		NewDefinedNameResource,
+		NewCommentResource,
+		NewHyperlinkResource,
+		NewImageResource,
+		NewChartResource,
+		NewPivotTableResource,
 	}
 }
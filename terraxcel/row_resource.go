@@ -0,0 +1,283 @@
+package terraxcel
+
+import (
+	"context"
+	"time"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &rowResource{}
+	_ resource.ResourceWithConfigure      = &rowResource{}
+	_ resource.ResourceWithValidateConfig = &rowResource{}
+)
+
+// NewRowResource is a helper function to simplify the provider implementation.
+func NewRowResource() resource.Resource {
+	return &rowResource{}
+}
+
+type rowResource struct {
+	client *client.Client
+}
+
+type rowResourceModel struct {
+	ID           types.String  `tfsdk:"id"`
+	LastUpdated  types.String  `tfsdk:"last_updated"`
+	WorkbookID   types.String  `tfsdk:"workbook_id"`
+	SheetID      types.String  `tfsdk:"sheet_id"`
+	Rows         types.String  `tfsdk:"rows"`
+	Height       types.Float64 `tfsdk:"height"`
+	AutoFit      types.Bool    `tfsdk:"auto_fit"`
+	Hidden       types.Bool    `tfsdk:"hidden"`
+	OutlineLevel types.Int64   `tfsdk:"outline_level"`
+}
+
+func (m *rowResourceModel) expand() *row {
+	return &row{
+		ID:           m.ID.ValueString(),
+		WorkbookID:   m.WorkbookID.ValueString(),
+		SheetID:      m.SheetID.ValueString(),
+		Rows:         m.Rows.ValueString(),
+		Height:       m.Height.ValueFloat64Pointer(),
+		AutoFit:      m.AutoFit.ValueBoolPointer(),
+		Hidden:       m.Hidden.ValueBoolPointer(),
+		OutlineLevel: m.OutlineLevel.ValueInt64Pointer(),
+	}
+}
+
+func (m *rowResourceModel) flatten(sheetRow *row) {
+	m.ID = types.StringValue(sheetRow.ID)
+	m.Rows = types.StringValue(sheetRow.Rows)
+	m.AutoFit = types.BoolPointerValue(sheetRow.AutoFit)
+	m.Hidden = types.BoolPointerValue(sheetRow.Hidden)
+	m.OutlineLevel = types.Int64PointerValue(sheetRow.OutlineLevel)
+
+	// the height of auto fitted rows depends on their content
+	if !m.AutoFit.ValueBool() {
+		m.Height = types.Float64PointerValue(sheetRow.Height)
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *rowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_row"
+}
+
+// Schema defines the schema for the resource.
+func (r *rowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sheet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// a row like 3 or rows like 3:10
+			"rows": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					rowSpan(),
+				},
+			},
+			// height in points, as in Excel
+			"height": schema.Float64Attribute{
+				Optional: true,
+				Validators: []validator.Float64{
+					float64Between(0, 409),
+				},
+			},
+			"auto_fit": schema.BoolAttribute{
+				Optional: true,
+			},
+			"hidden": schema.BoolAttribute{
+				Optional: true,
+			},
+			// level of the group the rows are in, 0 is not grouped
+			"outline_level": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64Between(0, 7),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure the height is not both set and auto fitted.
+func (r *rowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config rowResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Height.IsNull() && config.AutoFit.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("height"),
+			"Conflicting height",
+			"height can not be set for auto fitted rows",
+		)
+	}
+}
+
+func (r *rowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// creates the model, and populates it with values from the plan
+	var plan rowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// sets the rows with help of the client
+	sheetRow, err := createRow(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating row",
+			"Could not set rows "+plan.Rows.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// maps the values we got from the client to the terraform model
+	plan.flatten(sheetRow)
+
+	// updates last_updated
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// sets the state with the populated model
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *rowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state rowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed rows from client
+	sheetRow, err := readRow(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading row",
+			"Could not read row with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.flatten(sheetRow)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resets the rows and removes the Terraform state on success.
+func (r *rowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state rowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteRow(r.client, state.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting row",
+			"Could not reset rows, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update the rows
+func (r *rowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// old state
+	var state rowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retrieve values from plan
+	var plan rowResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	sheetRow, err := updateRow(r.client, plan.expand())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating row",
+			"Could not update rows, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	plan.flatten(sheetRow)
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rowResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
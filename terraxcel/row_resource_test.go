@@ -0,0 +1,43 @@
+package terraxcel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRowValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		height  types.Float64
+		autoFit types.Bool
+		valid   bool
+	}{
+		{"height", types.Float64Value(30), types.BoolNull(), true},
+		{"auto fit", types.Float64Null(), types.BoolValue(true), true},
+		{"height and auto fit", types.Float64Value(30), types.BoolValue(true), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &rowResource{}
+			s := resourceSchema(t, r)
+			config := rowResourceModel{
+				WorkbookID: types.StringValue("wb-1"),
+				SheetID:    types.StringValue("sheet-1"),
+				Rows:       types.StringValue("1:3"),
+				Height:     test.height,
+				AutoFit:    test.autoFit,
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newConfig(t, s, config)}, resp)
+
+			if valid := !resp.Diagnostics.HasError(); valid != test.valid {
+				t.Errorf("valid = %v, want %v: %v", valid, test.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
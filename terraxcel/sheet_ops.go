@@ -0,0 +1,152 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/excel-client-go/pkg/models"
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// readSheet reads a sheet with doRequest, client.ReadSheet panics as it sends
+// its request without a body.
+func readSheet(c *client.Client, workbookID, sheetID string) (*models.Sheet, error) {
+	sheet := &models.Sheet{}
+	err := doRequest(c, http.MethodGet, sheetEndpoint(workbookID, sheetID), nil, sheet, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return sheet, nil
+}
+
+// deleteSheet replaces client.DeleteSheet for the same reason as readSheet.
+func deleteSheet(c *client.Client, workbookID, sheetID string) error {
+	return doRequest(c, http.MethodDelete, sheetEndpoint(workbookID, sheetID), nil, nil, http.StatusOK)
+}
+
+// sheetView is how a sheet is shown when the workbook is opened.
+type sheetView struct {
+	FreezePanes   *string `json:"freeze_panes,omitempty"`
+	SplitPanes    *string `json:"split_panes,omitempty"`
+	Zoom          *int64  `json:"zoom,omitempty"`
+	ShowGridlines *bool   `json:"show_gridlines,omitempty"`
+	ShowHeaders   *bool   `json:"show_headers,omitempty"`
+	RightToLeft   *bool   `json:"right_to_left,omitempty"`
+	TabColor      *string `json:"tab_color,omitempty"`
+	Visibility    *string `json:"visibility,omitempty"`
+}
+
+func readSheetView(c *client.Client, workbookID, sheetID string) (*sheetView, error) {
+	view := &sheetView{}
+	err := doRequest(c, http.MethodGet, sheetEndpoint(workbookID, sheetID)+"/view", nil, view, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// updateSheetView replaces the view of the sheet, unset fields are reset.
+func updateSheetView(c *client.Client, workbookID, sheetID string, view *sheetView) (*sheetView, error) {
+	updated := &sheetView{}
+	err := doRequest(c, http.MethodPut, sheetEndpoint(workbookID, sheetID)+"/view", view, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func readSheets(c *client.Client, workbookID string) ([]models.Sheet, error) {
+	workbook, err := readWorkbook(c, workbookID)
+	if err != nil {
+		return nil, err
+	}
+	return workbook.Sheets, nil
+}
+
+// sheetProtection protects the locked cells of a sheet, the actions are what
+// users can still do while it is protected. The password is never returned.
+type sheetProtection struct {
+	Protected           bool    `json:"protected"`
+	Password            *string `json:"password,omitempty"`
+	SelectLockedCells   *bool   `json:"select_locked_cells,omitempty"`
+	SelectUnlockedCells *bool   `json:"select_unlocked_cells,omitempty"`
+	FormatCells         *bool   `json:"format_cells,omitempty"`
+	FormatColumns       *bool   `json:"format_columns,omitempty"`
+	FormatRows          *bool   `json:"format_rows,omitempty"`
+	InsertColumns       *bool   `json:"insert_columns,omitempty"`
+	InsertRows          *bool   `json:"insert_rows,omitempty"`
+	InsertHyperlinks    *bool   `json:"insert_hyperlinks,omitempty"`
+	DeleteColumns       *bool   `json:"delete_columns,omitempty"`
+	DeleteRows          *bool   `json:"delete_rows,omitempty"`
+	Sort                *bool   `json:"sort,omitempty"`
+	Autofilter          *bool   `json:"autofilter,omitempty"`
+	PivotTables         *bool   `json:"pivot_tables,omitempty"`
+	EditObjects         *bool   `json:"edit_objects,omitempty"`
+	EditScenarios       *bool   `json:"edit_scenarios,omitempty"`
+}
+
+func readSheetProtection(c *client.Client, workbookID, sheetID string) (*sheetProtection, error) {
+	protection := &sheetProtection{}
+	err := doRequest(c, http.MethodGet, sheetEndpoint(workbookID, sheetID)+"/protection", nil, protection, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return protection, nil
+}
+
+// updateSheetProtection protects the sheet, or removes the protection when
+// protection.Protected is false.
+func updateSheetProtection(c *client.Client, workbookID, sheetID string, protection *sheetProtection) (*sheetProtection, error) {
+	updated := &sheetProtection{}
+	err := doRequest(c, http.MethodPut, sheetEndpoint(workbookID, sheetID)+"/protection", protection, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// sheetPageSetup is how a sheet is printed. Margins are in inches, the breaks
+// are the rows and columns a new page starts at.
+type sheetPageSetup struct {
+	Orientation       *string      `json:"orientation,omitempty"`
+	PaperSize         *string      `json:"paper_size,omitempty"`
+	Margins           *pageMargins `json:"margins,omitempty"`
+	Scale             *int64       `json:"scale,omitempty"`
+	FitToWidth        *int64       `json:"fit_to_width,omitempty"`
+	FitToHeight       *int64       `json:"fit_to_height,omitempty"`
+	PrintArea         *string      `json:"print_area,omitempty"`
+	PrintTitleRows    *string      `json:"print_title_rows,omitempty"`
+	PrintTitleColumns *string      `json:"print_title_columns,omitempty"`
+	Header            *string      `json:"header,omitempty"`
+	Footer            *string      `json:"footer,omitempty"`
+	RowBreaks         []int64      `json:"row_breaks,omitempty"`
+	ColumnBreaks      []string     `json:"column_breaks,omitempty"`
+}
+
+type pageMargins struct {
+	Top    *float64 `json:"top,omitempty"`
+	Bottom *float64 `json:"bottom,omitempty"`
+	Left   *float64 `json:"left,omitempty"`
+	Right  *float64 `json:"right,omitempty"`
+	Header *float64 `json:"header,omitempty"`
+	Footer *float64 `json:"footer,omitempty"`
+}
+
+func readSheetPageSetup(c *client.Client, workbookID, sheetID string) (*sheetPageSetup, error) {
+	setup := &sheetPageSetup{}
+	err := doRequest(c, http.MethodGet, sheetEndpoint(workbookID, sheetID)+"/page-setup", nil, setup, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return setup, nil
+}
+
+// updateSheetPageSetup replaces the page setup, settings that are not set are
+// reset to the defaults of Excel.
+func updateSheetPageSetup(c *client.Client, workbookID, sheetID string, setup *sheetPageSetup) (*sheetPageSetup, error) {
+	updated := &sheetPageSetup{}
+	err := doRequest(c, http.MethodPut, sheetEndpoint(workbookID, sheetID)+"/page-setup", setup, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
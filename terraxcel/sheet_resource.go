@@ -3,19 +3,32 @@ package terraxcel
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/Deathfireofdoom/excel-client-go/pkg/models"
 	"github.com/Deathfireofdoom/terraxcel-client/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource              = &sheetResource{}
-	_ resource.ResourceWithConfigure = &sheetResource{}
+	_ resource.Resource                   = &sheetResource{}
+	_ resource.ResourceWithConfigure      = &sheetResource{}
+	_ resource.ResourceWithModifyPlan     = &sheetResource{}
+	_ resource.ResourceWithValidateConfig = &sheetResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -28,11 +41,217 @@ type sheetResource struct {
 }
 
 type sheetResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	WorkbookID  types.String `tfsdk:"workbook_id"`
-	Name        types.String `tfsdk:"name"`
-	Pos         types.Int64  `tfsdk:"pos"`
+	ID            types.String          `tfsdk:"id"`
+	LastUpdated   types.String          `tfsdk:"last_updated"`
+	WorkbookID    types.String          `tfsdk:"workbook_id"`
+	Name          types.String          `tfsdk:"name"`
+	Pos           types.Int64           `tfsdk:"pos"`
+	FreezePanes   types.String          `tfsdk:"freeze_panes"`
+	SplitPanes    types.String          `tfsdk:"split_panes"`
+	Zoom          types.Int64           `tfsdk:"zoom"`
+	ShowGridlines types.Bool            `tfsdk:"show_gridlines"`
+	ShowHeaders   types.Bool            `tfsdk:"show_headers"`
+	RightToLeft   types.Bool            `tfsdk:"right_to_left"`
+	TabColor      types.String          `tfsdk:"tab_color"`
+	Visibility    types.String          `tfsdk:"visibility"`
+	Protection    *sheetProtectionModel `tfsdk:"protection"`
+	PageSetup     *sheetPageSetupModel  `tfsdk:"page_setup"`
+}
+
+type sheetProtectionModel struct {
+	Password            types.String `tfsdk:"password"`
+	SelectLockedCells   types.Bool   `tfsdk:"select_locked_cells"`
+	SelectUnlockedCells types.Bool   `tfsdk:"select_unlocked_cells"`
+	FormatCells         types.Bool   `tfsdk:"format_cells"`
+	FormatColumns       types.Bool   `tfsdk:"format_columns"`
+	FormatRows          types.Bool   `tfsdk:"format_rows"`
+	InsertColumns       types.Bool   `tfsdk:"insert_columns"`
+	InsertRows          types.Bool   `tfsdk:"insert_rows"`
+	InsertHyperlinks    types.Bool   `tfsdk:"insert_hyperlinks"`
+	DeleteColumns       types.Bool   `tfsdk:"delete_columns"`
+	DeleteRows          types.Bool   `tfsdk:"delete_rows"`
+	Sort                types.Bool   `tfsdk:"sort"`
+	Autofilter          types.Bool   `tfsdk:"autofilter"`
+	PivotTables         types.Bool   `tfsdk:"pivot_tables"`
+	EditObjects         types.Bool   `tfsdk:"edit_objects"`
+	EditScenarios       types.Bool   `tfsdk:"edit_scenarios"`
+}
+
+type sheetPageSetupModel struct {
+	Orientation       types.String      `tfsdk:"orientation"`
+	PaperSize         types.String      `tfsdk:"paper_size"`
+	Margins           *pageMarginsModel `tfsdk:"margins"`
+	Scale             types.Int64       `tfsdk:"scale"`
+	FitToWidth        types.Int64       `tfsdk:"fit_to_width"`
+	FitToHeight       types.Int64       `tfsdk:"fit_to_height"`
+	PrintArea         types.String      `tfsdk:"print_area"`
+	PrintTitleRows    types.String      `tfsdk:"print_title_rows"`
+	PrintTitleColumns types.String      `tfsdk:"print_title_columns"`
+	Header            types.String      `tfsdk:"header"`
+	Footer            types.String      `tfsdk:"footer"`
+	RowBreaks         []types.Int64     `tfsdk:"row_breaks"`
+	ColumnBreaks      []types.String    `tfsdk:"column_breaks"`
+}
+
+type pageMarginsModel struct {
+	Top    types.Float64 `tfsdk:"top"`
+	Bottom types.Float64 `tfsdk:"bottom"`
+	Left   types.Float64 `tfsdk:"left"`
+	Right  types.Float64 `tfsdk:"right"`
+	Header types.Float64 `tfsdk:"header"`
+	Footer types.Float64 `tfsdk:"footer"`
+}
+
+func (m *sheetResourceModel) view() *sheetView {
+	return &sheetView{
+		FreezePanes:   m.FreezePanes.ValueStringPointer(),
+		SplitPanes:    m.SplitPanes.ValueStringPointer(),
+		Zoom:          m.Zoom.ValueInt64Pointer(),
+		ShowGridlines: m.ShowGridlines.ValueBoolPointer(),
+		ShowHeaders:   m.ShowHeaders.ValueBoolPointer(),
+		RightToLeft:   m.RightToLeft.ValueBoolPointer(),
+		TabColor:      m.TabColor.ValueStringPointer(),
+		Visibility:    m.Visibility.ValueStringPointer(),
+	}
+}
+
+func (m *sheetResourceModel) setView(view *sheetView) {
+	m.FreezePanes = types.StringPointerValue(view.FreezePanes)
+	m.SplitPanes = types.StringPointerValue(view.SplitPanes)
+	m.Zoom = types.Int64PointerValue(view.Zoom)
+	m.ShowGridlines = types.BoolPointerValue(view.ShowGridlines)
+	m.ShowHeaders = types.BoolPointerValue(view.ShowHeaders)
+	m.RightToLeft = types.BoolPointerValue(view.RightToLeft)
+	m.TabColor = types.StringPointerValue(view.TabColor)
+	m.Visibility = types.StringPointerValue(view.Visibility)
+}
+
+func (m *sheetResourceModel) protection() *sheetProtection {
+	if m.Protection == nil {
+		return &sheetProtection{}
+	}
+
+	return &sheetProtection{
+		Protected:           true,
+		Password:            m.Protection.Password.ValueStringPointer(),
+		SelectLockedCells:   m.Protection.SelectLockedCells.ValueBoolPointer(),
+		SelectUnlockedCells: m.Protection.SelectUnlockedCells.ValueBoolPointer(),
+		FormatCells:         m.Protection.FormatCells.ValueBoolPointer(),
+		FormatColumns:       m.Protection.FormatColumns.ValueBoolPointer(),
+		FormatRows:          m.Protection.FormatRows.ValueBoolPointer(),
+		InsertColumns:       m.Protection.InsertColumns.ValueBoolPointer(),
+		InsertRows:          m.Protection.InsertRows.ValueBoolPointer(),
+		InsertHyperlinks:    m.Protection.InsertHyperlinks.ValueBoolPointer(),
+		DeleteColumns:       m.Protection.DeleteColumns.ValueBoolPointer(),
+		DeleteRows:          m.Protection.DeleteRows.ValueBoolPointer(),
+		Sort:                m.Protection.Sort.ValueBoolPointer(),
+		Autofilter:          m.Protection.Autofilter.ValueBoolPointer(),
+		PivotTables:         m.Protection.PivotTables.ValueBoolPointer(),
+		EditObjects:         m.Protection.EditObjects.ValueBoolPointer(),
+		EditScenarios:       m.Protection.EditScenarios.ValueBoolPointer(),
+	}
+}
+
+// setProtection keeps the password of the model, as it is never returned.
+func (m *sheetResourceModel) setProtection(protection *sheetProtection) {
+	if !protection.Protected {
+		m.Protection = nil
+		return
+	}
+
+	password := types.StringNull()
+	if m.Protection != nil {
+		password = m.Protection.Password
+	}
+
+	m.Protection = &sheetProtectionModel{
+		Password:            password,
+		SelectLockedCells:   types.BoolPointerValue(protection.SelectLockedCells),
+		SelectUnlockedCells: types.BoolPointerValue(protection.SelectUnlockedCells),
+		FormatCells:         types.BoolPointerValue(protection.FormatCells),
+		FormatColumns:       types.BoolPointerValue(protection.FormatColumns),
+		FormatRows:          types.BoolPointerValue(protection.FormatRows),
+		InsertColumns:       types.BoolPointerValue(protection.InsertColumns),
+		InsertRows:          types.BoolPointerValue(protection.InsertRows),
+		InsertHyperlinks:    types.BoolPointerValue(protection.InsertHyperlinks),
+		DeleteColumns:       types.BoolPointerValue(protection.DeleteColumns),
+		DeleteRows:          types.BoolPointerValue(protection.DeleteRows),
+		Sort:                types.BoolPointerValue(protection.Sort),
+		Autofilter:          types.BoolPointerValue(protection.Autofilter),
+		PivotTables:         types.BoolPointerValue(protection.PivotTables),
+		EditObjects:         types.BoolPointerValue(protection.EditObjects),
+		EditScenarios:       types.BoolPointerValue(protection.EditScenarios),
+	}
+}
+
+func (m *sheetResourceModel) pageSetup() *sheetPageSetup {
+	if m.PageSetup == nil {
+		return &sheetPageSetup{}
+	}
+
+	setup := &sheetPageSetup{
+		Orientation:       m.PageSetup.Orientation.ValueStringPointer(),
+		PaperSize:         m.PageSetup.PaperSize.ValueStringPointer(),
+		Scale:             m.PageSetup.Scale.ValueInt64Pointer(),
+		FitToWidth:        m.PageSetup.FitToWidth.ValueInt64Pointer(),
+		FitToHeight:       m.PageSetup.FitToHeight.ValueInt64Pointer(),
+		PrintArea:         m.PageSetup.PrintArea.ValueStringPointer(),
+		PrintTitleRows:    m.PageSetup.PrintTitleRows.ValueStringPointer(),
+		PrintTitleColumns: m.PageSetup.PrintTitleColumns.ValueStringPointer(),
+		Header:            m.PageSetup.Header.ValueStringPointer(),
+		Footer:            m.PageSetup.Footer.ValueStringPointer(),
+		ColumnBreaks:      expandStrings(m.PageSetup.ColumnBreaks),
+	}
+	for _, row := range m.PageSetup.RowBreaks {
+		setup.RowBreaks = append(setup.RowBreaks, row.ValueInt64())
+	}
+	if margins := m.PageSetup.Margins; margins != nil {
+		setup.Margins = &pageMargins{
+			Top:    margins.Top.ValueFloat64Pointer(),
+			Bottom: margins.Bottom.ValueFloat64Pointer(),
+			Left:   margins.Left.ValueFloat64Pointer(),
+			Right:  margins.Right.ValueFloat64Pointer(),
+			Header: margins.Header.ValueFloat64Pointer(),
+			Footer: margins.Footer.ValueFloat64Pointer(),
+		}
+	}
+
+	return setup
+}
+
+// setPageSetup only refreshes a configured page setup, sheets printed with
+// the defaults of Excel have no page_setup.
+func (m *sheetResourceModel) setPageSetup(setup *sheetPageSetup) {
+	if m.PageSetup == nil {
+		return
+	}
+
+	m.PageSetup = &sheetPageSetupModel{
+		Orientation:       types.StringPointerValue(setup.Orientation),
+		PaperSize:         types.StringPointerValue(setup.PaperSize),
+		Scale:             types.Int64PointerValue(setup.Scale),
+		FitToWidth:        types.Int64PointerValue(setup.FitToWidth),
+		FitToHeight:       types.Int64PointerValue(setup.FitToHeight),
+		PrintArea:         types.StringPointerValue(setup.PrintArea),
+		PrintTitleRows:    types.StringPointerValue(setup.PrintTitleRows),
+		PrintTitleColumns: types.StringPointerValue(setup.PrintTitleColumns),
+		Header:            types.StringPointerValue(setup.Header),
+		Footer:            types.StringPointerValue(setup.Footer),
+		ColumnBreaks:      flattenStrings(setup.ColumnBreaks),
+	}
+	for _, row := range setup.RowBreaks {
+		m.PageSetup.RowBreaks = append(m.PageSetup.RowBreaks, types.Int64Value(row))
+	}
+	if margins := setup.Margins; margins != nil {
+		m.PageSetup.Margins = &pageMarginsModel{
+			Top:    types.Float64PointerValue(margins.Top),
+			Bottom: types.Float64PointerValue(margins.Bottom),
+			Left:   types.Float64PointerValue(margins.Left),
+			Right:  types.Float64PointerValue(margins.Right),
+			Header: types.Float64PointerValue(margins.Header),
+			Footer: types.Float64PointerValue(margins.Footer),
+		}
+	}
 }
 
 // Metadata returns the resource type name.
@@ -46,6 +265,9 @@ func (r *sheetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"workbook_id": schema.StringAttribute{
 				Required: true,
@@ -55,6 +277,283 @@ func (r *sheetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"pos": schema.Int64Attribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			// the top left cell of the scrolling pane, rows above and
+			// columns left of it are frozen
+			"freeze_panes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					cellReference(false),
+				},
+			},
+			"split_panes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					cellReference(false),
+				},
+			},
+			"zoom": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(100),
+				Validators: []validator.Int64{
+					int64Between(10, 400),
+				},
+			},
+			"show_gridlines": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"show_headers": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"right_to_left": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"tab_color": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					hexColor(),
+				},
+			},
+			// very_hidden sheets can only be shown again from the VBA editor
+			"visibility": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("visible"),
+				Validators: []validator.String{
+					stringOneOf("visible", "hidden", "very_hidden"),
+				},
+			},
+			// protects the locked cells of the sheet, the other attributes
+			// are the actions users can still do
+			"protection": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"select_locked_cells": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(true),
+					},
+					"select_unlocked_cells": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(true),
+					},
+					"format_cells": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"format_columns": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"format_rows": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"insert_columns": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"insert_rows": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"insert_hyperlinks": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"delete_columns": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"delete_rows": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"sort": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"autofilter": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"pivot_tables": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"edit_objects": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"edit_scenarios": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+				},
+			},
+			// how the sheet is printed, sheets without it are printed with
+			// the defaults of Excel
+			"page_setup": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"orientation": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("portrait"),
+						Validators: []validator.String{
+							stringOneOf("portrait", "landscape"),
+						},
+					},
+					"paper_size": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringOneOf("letter", "legal", "tabloid", "ledger", "executive", "a3", "a4", "a5", "b4", "b5"),
+						},
+					},
+					// margins in inches
+					"margins": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"top": schema.Float64Attribute{
+								Optional: true,
+								Computed: true,
+								Default:  float64default.StaticFloat64(0.75),
+								Validators: []validator.Float64{
+									float64Between(0, 49),
+								},
+							},
+							"bottom": schema.Float64Attribute{
+								Optional: true,
+								Computed: true,
+								Default:  float64default.StaticFloat64(0.75),
+								Validators: []validator.Float64{
+									float64Between(0, 49),
+								},
+							},
+							"left": schema.Float64Attribute{
+								Optional: true,
+								Computed: true,
+								Default:  float64default.StaticFloat64(0.7),
+								Validators: []validator.Float64{
+									float64Between(0, 49),
+								},
+							},
+							"right": schema.Float64Attribute{
+								Optional: true,
+								Computed: true,
+								Default:  float64default.StaticFloat64(0.7),
+								Validators: []validator.Float64{
+									float64Between(0, 49),
+								},
+							},
+							"header": schema.Float64Attribute{
+								Optional: true,
+								Computed: true,
+								Default:  float64default.StaticFloat64(0.3),
+								Validators: []validator.Float64{
+									float64Between(0, 49),
+								},
+							},
+							"footer": schema.Float64Attribute{
+								Optional: true,
+								Computed: true,
+								Default:  float64default.StaticFloat64(0.3),
+								Validators: []validator.Float64{
+									float64Between(0, 49),
+								},
+							},
+						},
+					},
+					// scales the printed sheet in percent
+					"scale": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64Between(10, 400),
+						},
+					},
+					// fits the printed sheet to a number of pages, 0 leaves
+					// the pages in that direction unlimited
+					"fit_to_width": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64Between(0, 32767),
+						},
+					},
+					"fit_to_height": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64Between(0, 32767),
+						},
+					},
+					"print_area": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							cellReference(true),
+						},
+					},
+					// rows and columns repeated on every printed page
+					"print_title_rows": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							rowSpan(),
+						},
+					},
+					"print_title_columns": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							columnSpan(),
+						},
+					},
+					"header": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							headerFooter(),
+						},
+					},
+					"footer": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							headerFooter(),
+						},
+					},
+					// rows and columns that start a new page
+					"row_breaks": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.Int64Type,
+					},
+					"column_breaks": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
@@ -63,6 +562,167 @@ func (r *sheetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+// ValidateConfig makes sure the panes are either frozen or split, and that the
+// page setup either scales or fits the printed sheet.
+func (r *sheetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sheetResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.FreezePanes.IsNull() && !config.SplitPanes.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("split_panes"),
+			"Conflicting panes",
+			"only one of freeze_panes and split_panes can be set",
+		)
+	}
+
+	setup := config.PageSetup
+	if setup == nil {
+		return
+	}
+
+	if !setup.Scale.IsNull() && (!setup.FitToWidth.IsNull() || !setup.FitToHeight.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("page_setup").AtName("scale"),
+			"Conflicting page setup",
+			"only one of scale and fit_to_width or fit_to_height can be set",
+		)
+	}
+
+	// a page can not break before the first row or column
+	for i, row := range setup.RowBreaks {
+		if row.IsNull() || row.IsUnknown() {
+			continue
+		}
+		if row.ValueInt64() < 2 || row.ValueInt64() > maxRow {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("page_setup").AtName("row_breaks").AtListIndex(i),
+				"Invalid page break",
+				fmt.Sprintf("row breaks must be between 2 and %d, got %d", maxRow, row.ValueInt64()),
+			)
+		}
+	}
+	for i, column := range setup.ColumnBreaks {
+		if column.IsNull() || column.IsUnknown() {
+			continue
+		}
+		if number, err := columnNumber(column.ValueString()); err != nil || number < 2 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("page_setup").AtName("column_breaks").AtListIndex(i),
+				"Invalid page break",
+				fmt.Sprintf("column breaks must be columns from B to %s, got %q", columnName(maxColumn), column.ValueString()),
+			)
+		}
+	}
+}
+
+// ModifyPlan makes sure a sheet is not hidden when it is the active sheet or
+// the last visible sheet of the workbook.
+func (r *sheetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check when the sheet is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan sheetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Visibility.IsUnknown() || plan.Visibility.ValueString() == "visible" {
+		return
+	}
+
+	// the workbook may not exist yet, or the provider not be configured
+	if r.client == nil || plan.WorkbookID.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	// the sheet itself is skipped, its id is taken from the state as the
+	// planned id is unknown when the sheet is created
+	var sheetID string
+	if !req.State.Raw.IsNull() {
+		var state sheetResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		sheetID = state.ID.ValueString()
+	}
+
+	resp.Diagnostics.Append(r.checkHidden(&plan, sheetID)...)
+}
+
+// sheetHideMutex serializes hiding sheets, so sheets hidden in the same apply
+// see each other when checking for the last visible sheet.
+var sheetHideMutex sync.Mutex
+
+// checkHidden makes sure the sheet with sheetID can be hidden as planned,
+// sheets on the server other than sheetID must keep a visible sheet.
+func (r *sheetResource) checkHidden(plan *sheetResourceModel, sheetID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	settings, err := readWorkbookSettings(r.client, plan.WorkbookID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Reading workbook settings",
+			"Could not read the settings of workbook "+plan.WorkbookID.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	if settings.ActiveSheet != nil && *settings.ActiveSheet == plan.Name.ValueString() {
+		diags.AddAttributeError(
+			path.Root("visibility"),
+			"Hiding the active sheet",
+			fmt.Sprintf("sheet %s is the active sheet of the workbook and can not be %s", plan.Name.ValueString(), plan.Visibility.ValueString()),
+		)
+		return diags
+	}
+
+	sheets, err := readSheets(r.client, plan.WorkbookID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Reading sheets",
+			"Could not read the sheets of workbook "+plan.WorkbookID.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	for _, sheet := range sheets {
+		if sheet.ID == sheetID {
+			continue
+		}
+
+		view, err := readSheetView(r.client, plan.WorkbookID.ValueString(), sheet.ID)
+		if err != nil {
+			diags.AddError(
+				"Error Reading sheet view",
+				"Could not read the view of sheet with ID "+sheet.ID+": "+err.Error(),
+			)
+			return diags
+		}
+
+		if view.Visibility == nil || *view.Visibility == "visible" {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("visibility"),
+		"Hiding the last visible sheet",
+		fmt.Sprintf("sheet %s is the last visible sheet of the workbook, a workbook needs at least one visible sheet", plan.Name.ValueString()),
+	)
+	return diags
+}
+
 func (r *sheetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// creates the model, and populates it with values from the plan
 	var plan sheetResourceModel
@@ -72,12 +732,13 @@ func (r *sheetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	planSheet, err := models.NewSheet(plan.ID.ValueString(), int(plan.Pos.ValueInt64()), plan.Name.ValueString(), "")
+	planSheet, err := models.NewSheet(plan.WorkbookID.ValueString(), int(plan.Pos.ValueInt64()), plan.Name.ValueString(), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"failed to create workbook",
 			fmt.Sprintf("failed to create sheet: %s", err.Error()),
 		)
+		return
 	}
 
 	// creates the sheet with help of the client
@@ -90,10 +751,58 @@ func (r *sheetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// removes the new sheet when any of the steps below fails, it would not
+	// be tracked in the state
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		err := deleteSheet(r.client, plan.WorkbookID.ValueString(), sheet.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting sheet",
+				"Could not delete sheet "+sheet.Name+" after failing to create it: "+err.Error(),
+			)
+		}
+	}()
+
+	// applies the view settings to the new sheet
+	view, err := updateSheetView(r.client, plan.WorkbookID.ValueString(), sheet.ID, plan.view())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating sheet view",
+			"Could not set the view of sheet "+sheet.Name+": "+err.Error(),
+		)
+		return
+	}
+
+	// protects the new sheet
+	protection, err := updateSheetProtection(r.client, plan.WorkbookID.ValueString(), sheet.ID, plan.protection())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating sheet protection",
+			"Could not set the protection of sheet "+sheet.Name+": "+err.Error(),
+		)
+		return
+	}
+
+	// sets up the printed pages of the new sheet
+	setup, err := updateSheetPageSetup(r.client, plan.WorkbookID.ValueString(), sheet.ID, plan.pageSetup())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating sheet page setup",
+			"Could not set the page setup of sheet "+sheet.Name+": "+err.Error(),
+		)
+		return
+	}
+
 	// maps the values we got from the client to the terraform model
 	plan.ID = types.StringValue(sheet.ID)
 	plan.Name = types.StringValue(sheet.Name)
 	plan.Pos = types.Int64Value(int64(sheet.Pos))
+	plan.setView(view)
+	plan.setProtection(protection)
+	plan.setPageSetup(setup)
 
 	// updates last_updated
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
@@ -117,7 +826,7 @@ func (r *sheetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Get refreshed sheet value from client
-	sheet, err := r.client.ReadSheet(state.WorkbookID.ValueString(), state.ID.ValueString())
+	sheet, err := readSheet(r.client, state.WorkbookID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Sheet",
@@ -126,10 +835,43 @@ func (r *sheetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// Get refreshed view settings from client
+	view, err := readSheetView(r.client, state.WorkbookID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading sheet view",
+			"Could not read the view of sheet with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Get refreshed protection from client
+	protection, err := readSheetProtection(r.client, state.WorkbookID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading sheet protection",
+			"Could not read the protection of sheet with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Get refreshed page setup from client
+	setup, err := readSheetPageSetup(r.client, state.WorkbookID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading sheet page setup",
+			"Could not read the page setup of sheet with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
 	// Overwrite items with refreshed state
 	state.ID = types.StringValue(sheet.ID)
 	state.Name = types.StringValue(sheet.Name)
 	state.Pos = types.Int64Value(int64(sheet.Pos))
+	state.setView(view)
+	state.setProtection(protection)
+	state.setPageSetup(setup)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -149,16 +891,7 @@ func (r *sheetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// create sheet from state
-	sheet := &models.Sheet{
-		ID:         state.ID.ValueString(),
-		WorkbookID: state.WorkbookID.ValueString(),
-		Name:       state.Name.ValueString(),
-		Pos:        int(state.Pos.ValueInt64()),
-	}
-
-	// Delete existing order
-	err := r.client.DeleteSheet(sheet)
+	err := deleteSheet(r.client, state.WorkbookID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting sheet",
@@ -185,12 +918,24 @@ func (r *sheetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Converts tf-workbook-model to excel.Sheet
+	// the plan only checked the sheets on the server, other sheets may
+	// have been hidden since
+	if plan.Visibility.ValueString() != "visible" && !plan.Visibility.Equal(state.Visibility) {
+		sheetHideMutex.Lock()
+		defer sheetHideMutex.Unlock()
+
+		resp.Diagnostics.Append(r.checkHidden(&plan, state.ID.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Converts tf-workbook-model to excel.Sheet, the position is kept
 	sheet := &models.Sheet{
 		ID:         state.ID.ValueString(),
 		WorkbookID: plan.WorkbookID.ValueString(),
 		Name:       plan.Name.ValueString(),
-		Pos:        int(plan.Pos.ValueInt64()),
+		Pos:        int(state.Pos.ValueInt64()),
 	}
 
 	// Update existing order
@@ -203,9 +948,39 @@ func (r *sheetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Update the view settings in place
+	view, err := updateSheetView(r.client, state.WorkbookID.ValueString(), state.ID.ValueString(), plan.view())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating sheet view",
+			"Could not update the view of sheet with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update the protection in place
+	protection, err := updateSheetProtection(r.client, state.WorkbookID.ValueString(), state.ID.ValueString(), plan.protection())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating sheet protection",
+			"Could not update the protection of sheet with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update the page setup in place
+	setup, err := updateSheetPageSetup(r.client, state.WorkbookID.ValueString(), state.ID.ValueString(), plan.pageSetup())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating sheet page setup",
+			"Could not update the page setup of sheet with ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
 	// Fetch updated items from GetOrder as UpdateOrder items are not
 	// populated.
-	sheet, err = r.client.ReadSheet(plan.WorkbookID.ValueString(), state.ID.ValueString())
+	sheet, err = readSheet(r.client, plan.WorkbookID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Sheet",
@@ -219,6 +994,9 @@ func (r *sheetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.WorkbookID = state.WorkbookID
 	plan.Name = types.StringValue(sheet.Name)
 	plan.Pos = types.Int64Value(int64(sheet.Pos))
+	plan.setView(view)
+	plan.setProtection(protection)
+	plan.setPageSetup(setup)
 
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -0,0 +1,289 @@
+package terraxcel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Deathfireofdoom/excel-client-go/pkg/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func sheetModel(id, name, visibility string) sheetResourceModel {
+	model := sheetResourceModel{
+		ID:         types.StringValue(id),
+		WorkbookID: types.StringValue("wb-1"),
+		Name:       types.StringValue(name),
+		Pos:        types.Int64Value(0),
+		Visibility: types.StringValue(visibility),
+	}
+	if id == "" {
+		model.ID = types.StringUnknown()
+		model.Pos = types.Int64Unknown()
+	}
+	return model
+}
+
+func TestSheetModifyPlanVisibility(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     *sheetResourceModel
+		plan      sheetResourceModel
+		others    map[string]string
+		wantError string
+	}{
+		{
+			name:   "hide with another visible sheet",
+			state:  ptr(sheetModel("sheet-1", "Data", "visible")),
+			plan:   sheetModel("sheet-1", "Data", "hidden"),
+			others: map[string]string{"sheet-2": "visible"},
+		},
+		{
+			name:      "hide the last visible sheet",
+			state:     ptr(sheetModel("sheet-1", "Data", "visible")),
+			plan:      sheetModel("sheet-1", "Data", "hidden"),
+			others:    map[string]string{"sheet-2": "hidden"},
+			wantError: "Hiding the last visible sheet",
+		},
+		{
+			// the planned id is unknown when it is not taken from the state
+			name:      "hide the last visible sheet with an unknown id",
+			state:     ptr(sheetModel("sheet-1", "Data", "visible")),
+			plan:      sheetModel("", "Data", "very_hidden"),
+			others:    map[string]string{"sheet-2": "very_hidden"},
+			wantError: "Hiding the last visible sheet",
+		},
+		{
+			name:      "hide the active sheet",
+			state:     ptr(sheetModel("sheet-1", "Summary", "visible")),
+			plan:      sheetModel("sheet-1", "Summary", "hidden"),
+			others:    map[string]string{"sheet-2": "visible"},
+			wantError: "Hiding the active sheet",
+		},
+		{
+			name:   "new hidden sheet",
+			plan:   sheetModel("", "Lookup", "hidden"),
+			others: map[string]string{"sheet-2": "visible"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, c := newFakeServer(t)
+			server.handle("GET "+workbookEndpoint("wb-1")+"/settings", http.StatusOK, workbookSettings{ActiveSheet: ptr("Summary")})
+
+			sheets := []models.Sheet{{ID: "sheet-1", WorkbookID: "wb-1", Name: test.plan.Name.ValueString()}}
+			server.handle("GET "+sheetEndpoint("wb-1", "sheet-1")+"/view", http.StatusOK, sheetView{Visibility: ptr("visible")})
+			for id, visibility := range test.others {
+				sheets = append(sheets, models.Sheet{ID: id, WorkbookID: "wb-1"})
+				server.handle("GET "+sheetEndpoint("wb-1", id)+"/view", http.StatusOK, sheetView{Visibility: ptr(visibility)})
+			}
+			server.handle("GET "+workbookEndpoint("wb-1"), http.StatusOK, models.Workbook{ID: "wb-1", Sheets: sheets})
+
+			r := &sheetResource{client: c}
+			s := resourceSchema(t, r)
+			req := resource.ModifyPlanRequest{
+				State: newState(t, s, nil),
+				Plan:  newPlan(t, s, test.plan),
+			}
+			if test.state != nil {
+				req.State = newState(t, s, test.state)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if test.wantError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !hasErrorSummary(resp.Diagnostics.Errors(), test.wantError) {
+				t.Fatalf("expected error %q, got %v", test.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSheetCreateCleanup(t *testing.T) {
+	for _, failing := range []string{"view", "protection", "page-setup"} {
+		t.Run(failing, func(t *testing.T) {
+			server, c := newFakeServer(t)
+			endpoint := sheetEndpoint("wb-1", "sheet-1")
+			server.handle("POST "+workbookEndpoint("wb-1")+"/sheet", http.StatusCreated, models.Sheet{ID: "sheet-1", WorkbookID: "wb-1", Name: "Data"})
+			server.handle("PUT "+endpoint+"/view", http.StatusOK, sheetView{Visibility: ptr("visible")})
+			server.handle("PUT "+endpoint+"/protection", http.StatusOK, sheetProtection{})
+			server.handle("PUT "+endpoint+"/page-setup", http.StatusOK, sheetPageSetup{})
+			server.handle("PUT "+endpoint+"/"+failing, http.StatusInternalServerError, nil)
+			server.handle("DELETE "+endpoint, http.StatusOK, nil)
+
+			r := &sheetResource{client: c}
+			s := resourceSchema(t, r)
+			plan := sheetModel("", "Data", "visible")
+			plan.LastUpdated = types.StringUnknown()
+
+			resp := &resource.CreateResponse{State: newState(t, s, nil)}
+			r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, plan)}, resp)
+
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected an error when the %s fails", failing)
+			}
+			if !server.received("DELETE " + endpoint) {
+				t.Errorf("the sheet was left behind after the %s failed", failing)
+			}
+		})
+	}
+}
+
+func TestSheetUpdateKeepsPosition(t *testing.T) {
+	server, c := newFakeServer(t)
+	endpoint := sheetEndpoint("wb-1", "sheet-1")
+	existing := models.Sheet{ID: "sheet-1", WorkbookID: "wb-1", Name: "Data", Pos: 3}
+	server.handle("PUT "+endpoint, http.StatusOK, existing)
+	server.handle("GET "+endpoint, http.StatusOK, existing)
+	server.handle("PUT "+endpoint+"/view", http.StatusOK, sheetView{Zoom: ptr(int64(150)), Visibility: ptr("visible")})
+	server.handle("PUT "+endpoint+"/protection", http.StatusOK, sheetProtection{})
+	server.handle("PUT "+endpoint+"/page-setup", http.StatusOK, sheetPageSetup{})
+
+	r := &sheetResource{client: c}
+	s := resourceSchema(t, r)
+
+	state := sheetModel("sheet-1", "Data", "visible")
+	state.Pos = types.Int64Value(3)
+	state.Zoom = types.Int64Value(100)
+	plan := state
+	plan.Pos = types.Int64Unknown()
+	plan.Zoom = types.Int64Value(150)
+
+	req := resource.UpdateRequest{State: newState(t, s, state), Plan: newPlan(t, s, plan)}
+	resp := &resource.UpdateResponse{State: newState(t, s, nil)}
+
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	var sent models.Sheet
+	server.decodeBody(t, "PUT "+endpoint, &sent)
+	if sent.Pos != 3 {
+		t.Errorf("sent position %d, want the position of the sheet 3", sent.Pos)
+	}
+}
+
+func TestSheetUpdateHidesLastVisibleSheet(t *testing.T) {
+	server, c := newFakeServer(t)
+	endpoint := sheetEndpoint("wb-1", "sheet-1")
+	server.handle("GET "+workbookEndpoint("wb-1")+"/settings", http.StatusOK, workbookSettings{ActiveSheet: ptr("Summary")})
+	server.handle("GET "+workbookEndpoint("wb-1"), http.StatusOK, models.Workbook{ID: "wb-1", Sheets: []models.Sheet{
+		{ID: "sheet-1", WorkbookID: "wb-1", Name: "Data"},
+		{ID: "sheet-2", WorkbookID: "wb-1", Name: "Lookup"},
+	}})
+	server.handle("GET "+endpoint+"/view", http.StatusOK, sheetView{Visibility: ptr("visible")})
+	// hidden by another resource in the same apply, after both were planned
+	server.handle("GET "+sheetEndpoint("wb-1", "sheet-2")+"/view", http.StatusOK, sheetView{Visibility: ptr("hidden")})
+	server.handle("PUT "+endpoint+"/view", http.StatusOK, sheetView{Visibility: ptr("hidden")})
+
+	r := &sheetResource{client: c}
+	s := resourceSchema(t, r)
+	req := resource.UpdateRequest{
+		State: newState(t, s, sheetModel("sheet-1", "Data", "visible")),
+		Plan:  newPlan(t, s, sheetModel("sheet-1", "Data", "hidden")),
+	}
+	resp := &resource.UpdateResponse{State: newState(t, s, nil)}
+
+	r.Update(context.Background(), req, resp)
+
+	if !hasErrorSummary(resp.Diagnostics.Errors(), "Hiding the last visible sheet") {
+		t.Fatalf("expected the last visible sheet not to be hidden, got %v", resp.Diagnostics)
+	}
+	if server.received("PUT " + endpoint + "/view") {
+		t.Errorf("the view was updated, the workbook has no visible sheet")
+	}
+}
+
+func TestSheetProtectionRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		protection *sheetProtectionModel
+	}{
+		{name: "not protected"},
+		{
+			name: "protected with password",
+			protection: &sheetProtectionModel{
+				Password:          types.StringValue("secret"),
+				SelectLockedCells: types.BoolValue(false),
+				FormatCells:       types.BoolValue(true),
+				Sort:              types.BoolValue(true),
+			},
+		},
+		{
+			name:       "protected without password",
+			protection: &sheetProtectionModel{Password: types.StringNull()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, c := newFakeServer(t)
+			endpoint := sheetEndpoint("wb-1", "sheet-1")
+			sheet := models.Sheet{ID: "sheet-1", WorkbookID: "wb-1", Name: "Input"}
+			server.handle("POST "+workbookEndpoint("wb-1")+"/sheet", http.StatusCreated, sheet)
+			server.handle("GET "+endpoint, http.StatusOK, sheet)
+			server.handle("PUT "+endpoint+"/view", http.StatusOK, sheetView{Visibility: ptr("visible")})
+			server.handle("GET "+endpoint+"/view", http.StatusOK, sheetView{Visibility: ptr("visible")})
+			server.handle("PUT "+endpoint+"/page-setup", http.StatusOK, sheetPageSetup{})
+			server.handle("GET "+endpoint+"/page-setup", http.StatusOK, sheetPageSetup{})
+
+			// the server stores the protection without its password
+			var stored sheetProtection
+			server.handleFunc("PUT "+endpoint+"/protection", func(body []byte) (int, interface{}) {
+				stored = sheetProtection{}
+				if err := json.Unmarshal(body, &stored); err != nil {
+					return http.StatusBadRequest, nil
+				}
+				stored.Password = nil
+				return http.StatusOK, stored
+			})
+			server.handleFunc("GET "+endpoint+"/protection", func([]byte) (int, interface{}) {
+				return http.StatusOK, stored
+			})
+
+			r := &sheetResource{client: c}
+			s := resourceSchema(t, r)
+			plan := sheetModel("", "Input", "visible")
+			plan.Protection = test.protection
+
+			createResp := &resource.CreateResponse{State: newState(t, s, nil)}
+			r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, plan)}, createResp)
+			if createResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error creating the sheet: %v", createResp.Diagnostics)
+			}
+
+			var sent sheetProtection
+			server.decodeBody(t, "PUT "+endpoint+"/protection", &sent)
+			if sent.Protected != (test.protection != nil) {
+				t.Errorf("sent protected = %v, want %v", sent.Protected, test.protection != nil)
+			}
+			if test.protection != nil && !equalPointer(sent.Password, test.protection.Password.ValueStringPointer()) {
+				t.Errorf("sent password %s, want %s", pointerString(sent.Password), test.protection.Password)
+			}
+
+			readResp := &resource.ReadResponse{State: createResp.State}
+			r.Read(context.Background(), resource.ReadRequest{State: createResp.State}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error reading the sheet: %v", readResp.Diagnostics)
+			}
+
+			var state sheetResourceModel
+			readResp.State.Get(context.Background(), &state)
+			if !reflect.DeepEqual(state.Protection, test.protection) {
+				t.Errorf("protection after refresh = %+v, want %+v", state.Protection, test.protection)
+			}
+		})
+	}
+}
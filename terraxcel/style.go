@@ -0,0 +1,283 @@
+package terraxcel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// styleModel holds the style attributes shared by the resources formatting
+// cells, each resource copies them to and from its own model.
+type styleModel struct {
+	Font         *fontModel
+	Fill         *fillModel
+	Borders      []borderModel
+	Alignment    *alignmentModel
+	NumberFormat types.String
+	Protection   *protectionModel
+}
+
+type fontModel struct {
+	Name          types.String  `tfsdk:"name"`
+	Size          types.Float64 `tfsdk:"size"`
+	Bold          types.Bool    `tfsdk:"bold"`
+	Italic        types.Bool    `tfsdk:"italic"`
+	Underline     types.String  `tfsdk:"underline"`
+	Strikethrough types.Bool    `tfsdk:"strikethrough"`
+	Color         types.String  `tfsdk:"color"`
+}
+
+type fillModel struct {
+	Pattern types.String `tfsdk:"pattern"`
+	Color   types.String `tfsdk:"color"`
+}
+
+type borderModel struct {
+	Position types.String `tfsdk:"position"`
+	Style    types.String `tfsdk:"style"`
+	Color    types.String `tfsdk:"color"`
+}
+
+type alignmentModel struct {
+	Horizontal types.String `tfsdk:"horizontal"`
+	Vertical   types.String `tfsdk:"vertical"`
+	WrapText   types.Bool   `tfsdk:"wrap_text"`
+	Indent     types.Int64  `tfsdk:"indent"`
+}
+
+type protectionModel struct {
+	Locked types.Bool `tfsdk:"locked"`
+	Hidden types.Bool `tfsdk:"hidden"`
+}
+
+// styleAttributes returns the schema of the style attributes.
+func styleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"font": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Optional: true,
+				},
+				"size": schema.Float64Attribute{
+					Optional: true,
+					Validators: []validator.Float64{
+						float64Between(1, 409),
+					},
+				},
+				"bold": schema.BoolAttribute{
+					Optional: true,
+				},
+				"italic": schema.BoolAttribute{
+					Optional: true,
+				},
+				"underline": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringOneOf("single", "double"),
+					},
+				},
+				"strikethrough": schema.BoolAttribute{
+					Optional: true,
+				},
+				"color": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						hexColor(),
+					},
+				},
+			},
+		},
+		"fill": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"pattern": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringOneOf("none", "solid", "dark_gray", "medium_gray", "light_gray", "gray125", "gray0625"),
+					},
+				},
+				"color": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						hexColor(),
+					},
+				},
+			},
+		},
+		"borders": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"position": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringOneOf("left", "right", "top", "bottom", "diagonal_up", "diagonal_down"),
+						},
+					},
+					"style": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringOneOf("thin", "medium", "thick", "dashed", "dotted", "double", "hair",
+								"medium_dashed", "dash_dot", "medium_dash_dot", "dash_dot_dot", "medium_dash_dot_dot", "slant_dash_dot"),
+						},
+					},
+					"color": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							hexColor(),
+						},
+					},
+				},
+			},
+		},
+		"alignment": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"horizontal": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringOneOf("general", "left", "center", "right", "fill", "justify", "center_continuous", "distributed"),
+					},
+				},
+				"vertical": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringOneOf("top", "center", "bottom", "justify", "distributed"),
+					},
+				},
+				"wrap_text": schema.BoolAttribute{
+					Optional: true,
+				},
+				"indent": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64Between(0, 250),
+					},
+				},
+			},
+		},
+		// number format code, e.g. "#,##0.00" or "yyyy-mm-dd"
+		"number_format": schema.StringAttribute{
+			Optional: true,
+		},
+		// cells are locked by default, which only takes effect once the
+		// sheet is protected
+		"protection": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"locked": schema.BoolAttribute{
+					Optional: true,
+				},
+				// hides the formula of the cell in the formula bar
+				"hidden": schema.BoolAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// expand converts the style attributes to the style sent to the server.
+func (m styleModel) expand() style {
+	result := style{
+		NumberFormat: m.NumberFormat.ValueStringPointer(),
+	}
+
+	if m.Font != nil {
+		result.Font = &font{
+			Name:          m.Font.Name.ValueStringPointer(),
+			Size:          m.Font.Size.ValueFloat64Pointer(),
+			Bold:          m.Font.Bold.ValueBoolPointer(),
+			Italic:        m.Font.Italic.ValueBoolPointer(),
+			Underline:     m.Font.Underline.ValueStringPointer(),
+			Strikethrough: m.Font.Strikethrough.ValueBoolPointer(),
+			Color:         m.Font.Color.ValueStringPointer(),
+		}
+	}
+
+	if m.Fill != nil {
+		result.Fill = &fill{
+			Pattern: m.Fill.Pattern.ValueStringPointer(),
+			Color:   m.Fill.Color.ValueStringPointer(),
+		}
+	}
+
+	for _, borderModel := range m.Borders {
+		result.Borders = append(result.Borders, border{
+			Position: borderModel.Position.ValueString(),
+			Style:    borderModel.Style.ValueString(),
+			Color:    borderModel.Color.ValueStringPointer(),
+		})
+	}
+
+	if m.Alignment != nil {
+		result.Alignment = &alignment{
+			Horizontal: m.Alignment.Horizontal.ValueStringPointer(),
+			Vertical:   m.Alignment.Vertical.ValueStringPointer(),
+			WrapText:   m.Alignment.WrapText.ValueBoolPointer(),
+			Indent:     m.Alignment.Indent.ValueInt64Pointer(),
+		}
+	}
+
+	if m.Protection != nil {
+		result.Protection = &protection{
+			Locked: m.Protection.Locked.ValueBoolPointer(),
+			Hidden: m.Protection.Hidden.ValueBoolPointer(),
+		}
+	}
+
+	return result
+}
+
+// flattenStyle converts the style read from the server to style attributes.
+func flattenStyle(s style) styleModel {
+	result := styleModel{
+		NumberFormat: types.StringPointerValue(s.NumberFormat),
+	}
+
+	if s.Font != nil {
+		result.Font = &fontModel{
+			Name:          types.StringPointerValue(s.Font.Name),
+			Size:          types.Float64PointerValue(s.Font.Size),
+			Bold:          types.BoolPointerValue(s.Font.Bold),
+			Italic:        types.BoolPointerValue(s.Font.Italic),
+			Underline:     types.StringPointerValue(s.Font.Underline),
+			Strikethrough: types.BoolPointerValue(s.Font.Strikethrough),
+			Color:         types.StringPointerValue(s.Font.Color),
+		}
+	}
+
+	if s.Fill != nil {
+		result.Fill = &fillModel{
+			Pattern: types.StringPointerValue(s.Fill.Pattern),
+			Color:   types.StringPointerValue(s.Fill.Color),
+		}
+	}
+
+	for _, border := range s.Borders {
+		result.Borders = append(result.Borders, borderModel{
+			Position: types.StringValue(border.Position),
+			Style:    types.StringValue(border.Style),
+			Color:    types.StringPointerValue(border.Color),
+		})
+	}
+
+	if s.Alignment != nil {
+		result.Alignment = &alignmentModel{
+			Horizontal: types.StringPointerValue(s.Alignment.Horizontal),
+			Vertical:   types.StringPointerValue(s.Alignment.Vertical),
+			WrapText:   types.BoolPointerValue(s.Alignment.WrapText),
+			Indent:     types.Int64PointerValue(s.Alignment.Indent),
+		}
+	}
+
+	if s.Protection != nil {
+		result.Protection = &protectionModel{
+			Locked: types.BoolPointerValue(s.Protection.Locked),
+			Hidden: types.BoolPointerValue(s.Protection.Hidden),
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,142 @@
+package terraxcel
+
+import (
+	"net/http"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// style is the formatting of a cell, unset fields are left as the default.
+type style struct {
+	Font         *font       `json:"font,omitempty"`
+	Fill         *fill       `json:"fill,omitempty"`
+	Borders      []border    `json:"borders,omitempty"`
+	Alignment    *alignment  `json:"alignment,omitempty"`
+	NumberFormat *string     `json:"number_format,omitempty"`
+	Protection   *protection `json:"protection,omitempty"`
+}
+
+type font struct {
+	Name          *string  `json:"name,omitempty"`
+	Size          *float64 `json:"size,omitempty"`
+	Bold          *bool    `json:"bold,omitempty"`
+	Italic        *bool    `json:"italic,omitempty"`
+	Underline     *string  `json:"underline,omitempty"`
+	Strikethrough *bool    `json:"strikethrough,omitempty"`
+	Color         *string  `json:"color,omitempty"`
+}
+
+type fill struct {
+	Pattern *string `json:"pattern,omitempty"`
+	Color   *string `json:"color,omitempty"`
+}
+
+type border struct {
+	Position string  `json:"position"`
+	Style    string  `json:"style"`
+	Color    *string `json:"color,omitempty"`
+}
+
+// protection only takes effect when the sheet is protected.
+type protection struct {
+	Locked *bool `json:"locked,omitempty"`
+	Hidden *bool `json:"hidden,omitempty"`
+}
+
+type alignment struct {
+	Horizontal *string `json:"horizontal,omitempty"`
+	Vertical   *string `json:"vertical,omitempty"`
+	WrapText   *bool   `json:"wrap_text,omitempty"`
+	Indent     *int64  `json:"indent,omitempty"`
+}
+
+// cellStyle is a style applied to a range of cells in a sheet, on top of the
+// named style if it has one.
+type cellStyle struct {
+	ID         string  `json:"id"`
+	WorkbookID string  `json:"workbook_id"`
+	SheetID    string  `json:"sheet_id"`
+	Range      string  `json:"range"`
+	StyleName  *string `json:"style_name,omitempty"`
+	Style      style   `json:"style"`
+}
+
+func cellStyleEndpoint(rangeStyle *cellStyle) string {
+	return sheetEndpoint(rangeStyle.WorkbookID, rangeStyle.SheetID) + "/style"
+}
+
+func createCellStyle(c *client.Client, rangeStyle *cellStyle) (*cellStyle, error) {
+	created := &cellStyle{}
+	err := doRequest(c, http.MethodPost, cellStyleEndpoint(rangeStyle), rangeStyle, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readCellStyle(c *client.Client, rangeStyle *cellStyle) (*cellStyle, error) {
+	read := &cellStyle{}
+	err := doRequest(c, http.MethodGet, cellStyleEndpoint(rangeStyle)+"/"+rangeStyle.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+func updateCellStyle(c *client.Client, rangeStyle *cellStyle) (*cellStyle, error) {
+	updated := &cellStyle{}
+	err := doRequest(c, http.MethodPut, cellStyleEndpoint(rangeStyle)+"/"+rangeStyle.ID, rangeStyle, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// deleteCellStyle resets the cells in the range to the default style.
+func deleteCellStyle(c *client.Client, rangeStyle *cellStyle) error {
+	return doRequest(c, http.MethodDelete, cellStyleEndpoint(rangeStyle)+"/"+rangeStyle.ID, nil, nil, http.StatusOK)
+}
+
+// namedStyle is a style defined once in a workbook, the server applies
+// changes to it to all cells using it.
+type namedStyle struct {
+	ID         string `json:"id"`
+	WorkbookID string `json:"workbook_id"`
+	Name       string `json:"name"`
+	Style      style  `json:"style"`
+}
+
+func namedStyleEndpoint(workbookStyle *namedStyle) string {
+	return workbookEndpoint(workbookStyle.WorkbookID) + "/named-style"
+}
+
+func createNamedStyle(c *client.Client, workbookStyle *namedStyle) (*namedStyle, error) {
+	created := &namedStyle{}
+	err := doRequest(c, http.MethodPost, namedStyleEndpoint(workbookStyle), workbookStyle, created, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func readNamedStyle(c *client.Client, workbookStyle *namedStyle) (*namedStyle, error) {
+	read := &namedStyle{}
+	err := doRequest(c, http.MethodGet, namedStyleEndpoint(workbookStyle)+"/"+workbookStyle.ID, nil, read, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return read, nil
+}
+
+func updateNamedStyle(c *client.Client, workbookStyle *namedStyle) (*namedStyle, error) {
+	updated := &namedStyle{}
+	err := doRequest(c, http.MethodPut, namedStyleEndpoint(workbookStyle)+"/"+workbookStyle.ID, workbookStyle, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deleteNamedStyle(c *client.Client, workbookStyle *namedStyle) error {
+	return doRequest(c, http.MethodDelete, namedStyleEndpoint(workbookStyle)+"/"+workbookStyle.ID, nil, nil, http.StatusOK)
+}
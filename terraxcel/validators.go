@@ -0,0 +1,285 @@
+package terraxcel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var hexColorRegexp = regexp.MustCompile(`^#?[0-9A-Fa-f]{6}$`)
+
+// stringOneOf validates that a string is one of the given values.
+func stringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+type stringOneOfValidator struct {
+	values []string
+}
+
+func (v stringOneOfValidator) Description(_ context.Context) string {
+	return "value must be one of: " + strings.Join(v.values, ", ")
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(v.values, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value",
+			fmt.Sprintf("%q is not valid, %s", req.ConfigValue.ValueString(), v.Description(ctx)),
+		)
+	}
+}
+
+// hexColor validates that a string is a color like FF0000 or #FF0000.
+func hexColor() validator.String {
+	return hexColorValidator{}
+}
+
+type hexColorValidator struct{}
+
+func (v hexColorValidator) Description(_ context.Context) string {
+	return "value must be a hex color like FF0000"
+}
+
+func (v hexColorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hexColorValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !hexColorRegexp.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid color",
+			fmt.Sprintf("%q is not valid, %s", req.ConfigValue.ValueString(), v.Description(ctx)),
+		)
+	}
+}
+
+// cellReference validates A1 references, and ranges like A1:C3 if
+// allowRange is set.
+func cellReference(allowRange bool) validator.String {
+	return cellReferenceValidator{allowRange: allowRange}
+}
+
+type cellReferenceValidator struct {
+	allowRange bool
+}
+
+func (v cellReferenceValidator) Description(_ context.Context) string {
+	if v.allowRange {
+		return "value must be a cell like A1 or a range like A1:C3"
+	}
+	return "value must be a cell like A1"
+}
+
+func (v cellReferenceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cellReferenceValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var err error
+	if v.allowRange {
+		_, err = parseCellRange(req.ConfigValue.ValueString())
+	} else {
+		_, _, err = parseCellReference(req.ConfigValue.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid cell reference",
+			fmt.Sprintf("%s, %s", err, v.Description(ctx)),
+		)
+	}
+}
+
+// int64Between validates that a number is within min and max, inclusive.
+func int64Between(min, max int64) validator.Int64 {
+	return int64BetweenValidator{min: min, max: max}
+}
+
+type int64BetweenValidator struct {
+	min int64
+	max int64
+}
+
+func (v int64BetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64BetweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value",
+			fmt.Sprintf("%d is not valid, %s", value, v.Description(ctx)),
+		)
+	}
+}
+
+// float64Between validates that a number is within min and max, inclusive.
+func float64Between(min, max float64) validator.Float64 {
+	return float64BetweenValidator{min: min, max: max}
+}
+
+type float64BetweenValidator struct {
+	min float64
+	max float64
+}
+
+func (v float64BetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %g and %g", v.min, v.max)
+}
+
+func (v float64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v float64BetweenValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+	if value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value",
+			fmt.Sprintf("%g is not valid, %s", value, v.Description(ctx)),
+		)
+	}
+}
+
+// columnSpan validates columns like B or B:D.
+func columnSpan() validator.String {
+	return spanValidator{columns: true}
+}
+
+// rowSpan validates rows like 3 or 3:10.
+func rowSpan() validator.String {
+	return spanValidator{columns: false}
+}
+
+type spanValidator struct {
+	columns bool
+}
+
+func (v spanValidator) Description(_ context.Context) string {
+	if v.columns {
+		return "value must be a column like B or columns like B:D"
+	}
+	return "value must be a row like 3 or rows like 3:10"
+}
+
+func (v spanValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v spanValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var err error
+	if v.columns {
+		_, _, err = parseColumnSpan(req.ConfigValue.ValueString())
+	} else {
+		_, _, err = parseRowSpan(req.ConfigValue.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid span",
+			fmt.Sprintf("%s, %s", err, v.Description(ctx)),
+		)
+	}
+}
+
+// excelName validates the name of a table or defined name.
+func excelName() validator.String {
+	return excelNameValidator{}
+}
+
+type excelNameValidator struct{}
+
+func (v excelNameValidator) Description(_ context.Context) string {
+	return "value must start with a letter, underscore or backslash, contain only letters, digits, underscores, periods and backslashes, and not be a cell reference"
+}
+
+func (v excelNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v excelNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateExcelName(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid name",
+			fmt.Sprintf("%s, %s", err, v.Description(ctx)),
+		)
+	}
+}
+
+// headerFooter validates the codes of the header or footer of printed pages.
+func headerFooter() validator.String {
+	return headerFooterValidator{}
+}
+
+type headerFooterValidator struct{}
+
+func (v headerFooterValidator) Description(_ context.Context) string {
+	return "value must only contain codes like &P, &N, &D, &T, &F, &A, &L, &C and &R, use && for an ampersand"
+}
+
+func (v headerFooterValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v headerFooterValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateHeaderFooter(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid header or footer",
+			fmt.Sprintf("%s, %s", err, v.Description(ctx)),
+		)
+	}
+}
@@ -0,0 +1,110 @@
+package terraxcel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStringValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		valid     bool
+	}{
+		{"one of", stringOneOf("left", "right"), types.StringValue("left"), true},
+		{"not one of", stringOneOf("left", "right"), types.StringValue("center"), false},
+		{"one of is case sensitive", stringOneOf("left", "right"), types.StringValue("Left"), false},
+		{"null", stringOneOf("left", "right"), types.StringNull(), true},
+		{"unknown", stringOneOf("left", "right"), types.StringUnknown(), true},
+
+		{"color", hexColor(), types.StringValue("FF0000"), true},
+		{"color with hash", hexColor(), types.StringValue("#00ff00"), true},
+		{"short color", hexColor(), types.StringValue("F00"), false},
+		{"color with alpha", hexColor(), types.StringValue("FF000080"), false},
+		{"named color", hexColor(), types.StringValue("red"), false},
+
+		{"cell", cellReference(false), types.StringValue("B2"), true},
+		{"range as cell", cellReference(false), types.StringValue("A1:C3"), false},
+		{"range", cellReference(true), types.StringValue("A1:C3"), true},
+		{"cell as range", cellReference(true), types.StringValue("B2"), true},
+		{"invalid range", cellReference(true), types.StringValue("A1:"), false},
+
+		{"column", columnSpan(), types.StringValue("B"), true},
+		{"columns", columnSpan(), types.StringValue("$B:$D"), true},
+		{"column after XFD", columnSpan(), types.StringValue("XFE"), false},
+		{"row as column", columnSpan(), types.StringValue("3"), false},
+		{"row", rowSpan(), types.StringValue("3"), true},
+		{"rows", rowSpan(), types.StringValue("3:10"), true},
+		{"row 0", rowSpan(), types.StringValue("0"), false},
+		{"column as row", rowSpan(), types.StringValue("B"), false},
+
+		{"name", excelName(), types.StringValue("Sales"), true},
+		{"cell as name", excelName(), types.StringValue("A1"), false},
+
+		{"header", headerFooter(), types.StringValue("Page &P of &N"), true},
+		{"invalid header", headerFooter(), types.StringValue("R&Q"), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("value"), ConfigValue: test.value}
+			resp := &validator.StringResponse{}
+			test.validator.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() == test.valid {
+				t.Errorf("%s: valid = %v, want %v: %v", test.value, !resp.Diagnostics.HasError(), test.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestInt64Between(t *testing.T) {
+	tests := []struct {
+		value types.Int64
+		valid bool
+	}{
+		{types.Int64Value(1), true},
+		{types.Int64Value(409), true},
+		{types.Int64Value(0), false},
+		{types.Int64Value(410), false},
+		{types.Int64Null(), true},
+		{types.Int64Unknown(), true},
+	}
+
+	for _, test := range tests {
+		req := validator.Int64Request{Path: path.Root("value"), ConfigValue: test.value}
+		resp := &validator.Int64Response{}
+		int64Between(1, 409).ValidateInt64(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() == test.valid {
+			t.Errorf("%s: valid = %v, want %v", test.value, !resp.Diagnostics.HasError(), test.valid)
+		}
+	}
+}
+
+func TestFloat64Between(t *testing.T) {
+	tests := []struct {
+		value types.Float64
+		valid bool
+	}{
+		{types.Float64Value(0), true},
+		{types.Float64Value(0.5), true},
+		{types.Float64Value(1), true},
+		{types.Float64Value(-0.1), false},
+		{types.Float64Value(1.01), false},
+		{types.Float64Null(), true},
+		{types.Float64Unknown(), true},
+	}
+
+	for _, test := range tests {
+		req := validator.Float64Request{Path: path.Root("value"), ConfigValue: test.value}
+		resp := &validator.Float64Response{}
+		float64Between(0, 1).ValidateFloat64(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() == test.valid {
+			t.Errorf("%s: valid = %v, want %v", test.value, !resp.Diagnostics.HasError(), test.valid)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package terraxcel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &workbookExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &workbookExportDataSource{}
+)
+
+func NewWorkbookExportDataSource() datasource.DataSource {
+	return &workbookExportDataSource{}
+}
+
+type workbookExportDataSource struct {
+	client *client.Client
+}
+
+type workbookExportDataSourceModel struct {
+	WorkbookID    types.String `tfsdk:"workbook_id"`
+	OutputPath    types.String `tfsdk:"output_path"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+}
+
+func (d *workbookExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workbook_export"
+}
+
+func (d *workbookExportDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workbook_id": schema.StringAttribute{
+				Required: true,
+			},
+			// the workbook is only written to disk if a path is given
+			"output_path": schema.StringAttribute{
+				Optional: true,
+			},
+			"content_base64": schema.StringAttribute{
+				Computed: true,
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *workbookExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workbookExportDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// downloads the current bytes of the workbook
+	content, err := readWorkbookContent(d.client, state.WorkbookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to export workbook",
+			fmt.Sprintf("Unable to download workbook with ID %s: %s", state.WorkbookID.ValueString(), err),
+		)
+		return
+	}
+
+	if !state.OutputPath.IsNull() {
+		outputPath := state.OutputPath.ValueString()
+
+		err = os.MkdirAll(filepath.Dir(outputPath), 0755)
+		if err == nil {
+			err = os.WriteFile(outputPath, content.Content, 0644)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to export workbook",
+				fmt.Sprintf("Unable to write workbook with ID %s to %s: %s", state.WorkbookID.ValueString(), outputPath, err),
+			)
+			return
+		}
+	}
+
+	// maps response from client to state
+	checksum := sha256.Sum256(content.Content)
+	state.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(content.Content))
+	state.ContentSHA256 = types.StringValue(hex.EncodeToString(checksum[:]))
+
+	// set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *workbookExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
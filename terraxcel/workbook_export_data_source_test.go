@@ -0,0 +1,73 @@
+package terraxcel
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWorkbookExportRead(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "exports", "report.xlsx")
+
+	tests := []struct {
+		name       string
+		outputPath types.String
+	}{
+		{name: "content only", outputPath: types.StringNull()},
+		{name: "written to disk", outputPath: types.StringValue(outputPath)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, c := newFakeServer(t)
+			server.handle("GET "+workbookEndpoint("wb-1")+"/content", http.StatusOK, workbookContent{Content: []byte("report")})
+
+			d := &workbookExportDataSource{client: c}
+			schemaResp := &datasource.SchemaResponse{}
+			d.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+			s := schemaResp.Schema
+
+			config := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+			state := tfsdk.State{Schema: s, Raw: config.Raw}
+			if diags := state.Set(context.Background(), workbookExportDataSourceModel{
+				WorkbookID: types.StringValue("wb-1"),
+				OutputPath: test.outputPath,
+			}); diags.HasError() {
+				t.Fatalf("could not set config: %v", diags)
+			}
+			config.Raw = state.Raw
+
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: config.Raw}}
+			d.Read(context.Background(), datasource.ReadRequest{Config: config}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got workbookExportDataSourceModel
+			resp.State.Get(context.Background(), &got)
+			if got.ContentBase64.ValueString() != base64.StdEncoding.EncodeToString([]byte("report")) {
+				t.Errorf("content_base64 = %s, want the content of the workbook", got.ContentBase64)
+			}
+			if got.ContentSHA256.ValueString() != contentSHA256([]byte("report")) {
+				t.Errorf("content_sha256 = %s, want the hash of the content", got.ContentSHA256)
+			}
+
+			if test.outputPath.IsNull() {
+				return
+			}
+			written, err := os.ReadFile(outputPath)
+			if err != nil || string(written) != "report" {
+				t.Errorf("wrote %q, %v to %s, want the content of the workbook", written, err, outputPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package terraxcel
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/Deathfireofdoom/excel-client-go/pkg/models"
+	"github.com/Deathfireofdoom/terraxcel-client/client"
+)
+
+// Extensions the server can convert between, next to the ones in models.
+const (
+	extensionCSV models.Extension = "csv"
+	extensionODS models.Extension = "ods"
+)
+
+// readWorkbook reads a workbook with doRequest, client.ReadWorkbook panics as
+// it sends its request without a body.
+func readWorkbook(c *client.Client, workbookID string) (*models.Workbook, error) {
+	workbook := &models.Workbook{}
+	err := doRequest(c, http.MethodGet, workbookEndpoint(workbookID), nil, workbook, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return workbook, nil
+}
+
+// deleteWorkbook deletes a workbook with doRequest, client.DeleteWorkbook
+// panics as it sends its request without a body.
+func deleteWorkbook(c *client.Client, workbookID string) error {
+	return doRequest(c, http.MethodDelete, workbookEndpoint(workbookID), nil, nil, http.StatusOK)
+}
+
+type workbookConversion struct {
+	Extension models.Extension `json:"extension"`
+}
+
+// convertWorkbook converts the content of the workbook into the format of
+// the given extension, the file keeps its name and folder.
+func convertWorkbook(c *client.Client, workbookID string, extension models.Extension) (*models.Workbook, error) {
+	workbook := &models.Workbook{}
+	err := doRequest(c, http.MethodPost, workbookEndpoint(workbookID)+"/convert", workbookConversion{Extension: extension}, workbook, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return workbook, nil
+}
+
+// conversionWarnings lists what is lost when converting a workbook from one
+// extension to another.
+func conversionWarnings(from, to models.Extension) []string {
+	var warnings []string
+
+	if from == models.XLSXM && to != models.XLSXM {
+		warnings = append(warnings, "macros are removed, only xlsm can store them")
+	}
+
+	switch to {
+	case extensionCSV:
+		warnings = append(warnings,
+			"only the first sheet is kept, csv can not store multiple sheets",
+			"styles, formulas and comments are lost, only the values are kept",
+		)
+	case models.XLS:
+		warnings = append(warnings,
+			"rows after 65536 and columns after IV are truncated",
+			"features newer than Excel 97-2003, such as tables and some conditional formats, are lost",
+		)
+	case extensionODS:
+		warnings = append(warnings, "styles and Excel specific features may not be kept as is")
+	}
+
+	return warnings
+}
+
+// workbookContent replaces the content of a workbook, either with the bytes
+// of a file or with a copy of a template stored on the server. Password opens
+// the file or template if it is encrypted.
+type workbookContent struct {
+	Content    []byte `json:"content,omitempty"`
+	TemplateID string `json:"template_id,omitempty"`
+	Password   string `json:"password,omitempty"`
+}
+
+func updateWorkbookContent(c *client.Client, workbookID string, content workbookContent) error {
+	return doRequest(c, http.MethodPut, workbookEndpoint(workbookID)+"/content", content, nil, http.StatusOK)
+}
+
+func readWorkbookContent(c *client.Client, workbookID string) (*workbookContent, error) {
+	content := &workbookContent{}
+	err := doRequest(c, http.MethodGet, workbookEndpoint(workbookID)+"/content", nil, content, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// workbookEncryption encrypts the file of the workbook with an open password.
+// The server keeps the password, so it can still read and write the workbook,
+// but never returns it.
+type workbookEncryption struct {
+	Encrypted       bool    `json:"encrypted"`
+	Password        *string `json:"password,omitempty"`
+	CurrentPassword *string `json:"current_password,omitempty"`
+}
+
+func readWorkbookEncryption(c *client.Client, workbookID string) (*workbookEncryption, error) {
+	encryption := &workbookEncryption{}
+	err := doRequest(c, http.MethodGet, workbookEndpoint(workbookID)+"/encryption", nil, encryption, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return encryption, nil
+}
+
+// updateWorkbookEncryption encrypts the workbook with the password, or
+// decrypts it when the password is nil. CurrentPassword must be set when the
+// workbook is already encrypted.
+func updateWorkbookEncryption(c *client.Client, workbookID string, encryption *workbookEncryption) (*workbookEncryption, error) {
+	updated := &workbookEncryption{}
+	err := doRequest(c, http.MethodPut, workbookEndpoint(workbookID)+"/encryption", encryption, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// encryptionExtensions are the extensions that support agile encryption.
+var encryptionExtensions = []models.Extension{models.XLSX, models.XLSXM}
+
+// workbookSettings are the settings of a workbook itself, unset fields are
+// reset to their default.
+type workbookSettings struct {
+	ActiveSheet      *string             `json:"active_sheet,omitempty"`
+	Protection       *workbookProtection `json:"protection,omitempty"`
+	Properties       *documentProperties `json:"properties,omitempty"`
+	CustomProperties map[string]string   `json:"custom_properties,omitempty"`
+}
+
+// documentProperties are the core properties of the workbook, shown under
+// File > Info in Excel.
+type documentProperties struct {
+	Title       *string `json:"title,omitempty"`
+	Subject     *string `json:"subject,omitempty"`
+	Author      *string `json:"author,omitempty"`
+	Manager     *string `json:"manager,omitempty"`
+	Company     *string `json:"company,omitempty"`
+	Category    *string `json:"category,omitempty"`
+	Keywords    *string `json:"keywords,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// propertiesExtensions are the extensions that store document properties.
+var propertiesExtensions = []models.Extension{models.XLSX, models.XLSXM, models.XLS, extensionODS}
+
+// workbookProtection stops users from changing the sheets of the workbook,
+// or its windows. The password is never returned.
+type workbookProtection struct {
+	Password  *string `json:"password,omitempty"`
+	Structure *bool   `json:"structure,omitempty"`
+	Windows   *bool   `json:"windows,omitempty"`
+}
+
+func readWorkbookSettings(c *client.Client, workbookID string) (*workbookSettings, error) {
+	settings := &workbookSettings{}
+	err := doRequest(c, http.MethodGet, workbookEndpoint(workbookID)+"/settings", nil, settings, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func updateWorkbookSettings(c *client.Client, workbookID string, settings *workbookSettings) (*workbookSettings, error) {
+	updated := &workbookSettings{}
+	err := doRequest(c, http.MethodPut, workbookEndpoint(workbookID)+"/settings", settings, updated, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// templateExtensions maps a workbook extension to the extensions of the
+// files it can be created from.
+var templateExtensions = map[models.Extension][]string{
+	models.XLSX:  {"xlsx", "xltx"},
+	models.XLSXM: {"xlsm", "xltm", "xlsx", "xltx"},
+	models.XLS:   {"xls", "xlt"},
+	extensionCSV: {"csv"},
+	extensionODS: {"ods", "ots"},
+}
+
+// fileExtension returns the lower case extension of the file, without the dot.
+func fileExtension(name string) string {
+	return strings.ToLower(strings.TrimPrefix(filepath.Ext(name), "."))
+}
@@ -0,0 +1,38 @@
+package terraxcel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Deathfireofdoom/excel-client-go/pkg/models"
+)
+
+func TestConversionWarnings(t *testing.T) {
+	tests := []struct {
+		from models.Extension
+		to   models.Extension
+		want []string
+	}{
+		{models.XLSX, models.XLSX, nil},
+		{models.XLSX, models.XLSXM, nil},
+		{models.XLSXM, models.XLSXM, nil},
+		{models.XLSXM, models.XLSX, []string{"macros"}},
+		{models.XLSX, extensionCSV, []string{"first sheet", "only the values"}},
+		{models.XLSXM, extensionCSV, []string{"macros", "first sheet", "only the values"}},
+		{models.XLSX, models.XLS, []string{"65536", "Excel 97-2003"}},
+		{models.XLSX, extensionODS, []string{"Excel specific"}},
+	}
+
+	for _, test := range tests {
+		warnings := conversionWarnings(test.from, test.to)
+		if len(warnings) != len(test.want) {
+			t.Errorf("conversionWarnings(%s, %s) = %q, want %d warnings", test.from, test.to, warnings, len(test.want))
+			continue
+		}
+		for i, want := range test.want {
+			if !strings.Contains(warnings[i], want) {
+				t.Errorf("conversionWarnings(%s, %s)[%d] = %q, want it to mention %q", test.from, test.to, i, warnings[i], want)
+			}
+		}
+	}
+}
@@ -3,19 +3,28 @@ package terraxcel
 import (
 	"context"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/Deathfireofdoom/excel-client-go/pkg/models"
 	"github.com/Deathfireofdoom/terraxcel-client/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource              = &workbookResource{}
-	_ resource.ResourceWithConfigure = &workbookResource{}
+	_ resource.Resource                   = &workbookResource{}
+	_ resource.ResourceWithConfigure      = &workbookResource{}
+	_ resource.ResourceWithModifyPlan     = &workbookResource{}
+	_ resource.ResourceWithValidateConfig = &workbookResource{}
 )
 
 func NewWorkbookResource() resource.Resource {
@@ -27,11 +36,120 @@ type workbookResource struct {
 }
 
 type workbookResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	FileName    types.String `tfsdk:"file_name"`
-	Extension   types.String `tfsdk:"extension"`
-	FolderPath  types.String `tfsdk:"folder_path"`
+	ID               types.String             `tfsdk:"id"`
+	LastUpdated      types.String             `tfsdk:"last_updated"`
+	FileName         types.String             `tfsdk:"file_name"`
+	Extension        types.String             `tfsdk:"extension"`
+	FolderPath       types.String             `tfsdk:"folder_path"`
+	TemplatePath     types.String             `tfsdk:"template_path"`
+	TemplateID       types.String             `tfsdk:"template_id"`
+	Source           types.String             `tfsdk:"source"`
+	SourceHash       types.String             `tfsdk:"source_hash"`
+	ActiveSheet      types.String             `tfsdk:"active_sheet"`
+	Password         types.String             `tfsdk:"password"`
+	Protection       *workbookProtectionModel `tfsdk:"protection"`
+	Properties       *documentPropertiesModel `tfsdk:"properties"`
+	CustomProperties map[string]types.String  `tfsdk:"custom_properties"`
+}
+
+type workbookProtectionModel struct {
+	Password  types.String `tfsdk:"password"`
+	Structure types.Bool   `tfsdk:"structure"`
+	Windows   types.Bool   `tfsdk:"windows"`
+}
+
+type documentPropertiesModel struct {
+	Title       types.String `tfsdk:"title"`
+	Subject     types.String `tfsdk:"subject"`
+	Author      types.String `tfsdk:"author"`
+	Manager     types.String `tfsdk:"manager"`
+	Company     types.String `tfsdk:"company"`
+	Category    types.String `tfsdk:"category"`
+	Keywords    types.String `tfsdk:"keywords"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m *workbookResourceModel) settings() *workbookSettings {
+	settings := &workbookSettings{
+		ActiveSheet: m.ActiveSheet.ValueStringPointer(),
+	}
+
+	if m.Properties != nil {
+		settings.Properties = &documentProperties{
+			Title:       m.Properties.Title.ValueStringPointer(),
+			Subject:     m.Properties.Subject.ValueStringPointer(),
+			Author:      m.Properties.Author.ValueStringPointer(),
+			Manager:     m.Properties.Manager.ValueStringPointer(),
+			Company:     m.Properties.Company.ValueStringPointer(),
+			Category:    m.Properties.Category.ValueStringPointer(),
+			Keywords:    m.Properties.Keywords.ValueStringPointer(),
+			Description: m.Properties.Description.ValueStringPointer(),
+		}
+	}
+
+	for name, value := range m.CustomProperties {
+		if settings.CustomProperties == nil {
+			settings.CustomProperties = map[string]string{}
+		}
+		settings.CustomProperties[name] = value.ValueString()
+	}
+
+	if m.Protection != nil {
+		settings.Protection = &workbookProtection{
+			Password:  m.Protection.Password.ValueStringPointer(),
+			Structure: m.Protection.Structure.ValueBoolPointer(),
+			Windows:   m.Protection.Windows.ValueBoolPointer(),
+		}
+	}
+
+	return settings
+}
+
+func (m *workbookResourceModel) setSettings(settings *workbookSettings) {
+	// the active sheet is only refreshed when it is configured, as the server
+	// reports the first sheet of workbooks without one
+	if !m.ActiveSheet.IsNull() {
+		m.ActiveSheet = types.StringPointerValue(settings.ActiveSheet)
+	}
+
+	// the properties are only refreshed when they are configured, as the
+	// server sets properties like the author of new workbooks itself
+	if m.Properties != nil && settings.Properties != nil {
+		m.Properties = &documentPropertiesModel{
+			Title:       types.StringPointerValue(settings.Properties.Title),
+			Subject:     types.StringPointerValue(settings.Properties.Subject),
+			Author:      types.StringPointerValue(settings.Properties.Author),
+			Manager:     types.StringPointerValue(settings.Properties.Manager),
+			Company:     types.StringPointerValue(settings.Properties.Company),
+			Category:    types.StringPointerValue(settings.Properties.Category),
+			Keywords:    types.StringPointerValue(settings.Properties.Keywords),
+			Description: types.StringPointerValue(settings.Properties.Description),
+		}
+	}
+
+	if m.CustomProperties != nil {
+		m.CustomProperties = map[string]types.String{}
+		for name, value := range settings.CustomProperties {
+			m.CustomProperties[name] = types.StringValue(value)
+		}
+	}
+
+	if settings.Protection == nil {
+		m.Protection = nil
+		return
+	}
+
+	// the password is never returned, so the one of the model is kept
+	password := types.StringNull()
+	if m.Protection != nil {
+		password = m.Protection.Password
+	}
+
+	m.Protection = &workbookProtectionModel{
+		Password:  password,
+		Structure: types.BoolPointerValue(settings.Protection.Structure),
+		Windows:   types.BoolPointerValue(settings.Protection.Windows),
+	}
 }
 
 func (r *workbookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,16 +161,110 @@ func (r *workbookResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
+			// file_name and folder_path are renamed/moved in place, the
+			// content of the workbook is preserved
 			"file_name": schema.StringAttribute{
 				Required: true,
 			},
 			"folder_path": schema.StringAttribute{
 				Required: true,
 			},
+			// changing the extension converts the content of the workbook
 			"extension": schema.StringAttribute{
 				Required: true,
 			},
+			// the template is only used when the workbook is created
+			"template_path": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// the source is uploaded again when it or its hash changes
+			"source": schema.StringAttribute{
+				Optional: true,
+			},
+			"source_hash": schema.StringAttribute{
+				Optional: true,
+			},
+			// open password the file is encrypted with, it also opens an
+			// encrypted template or source
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			// name of the sheet the workbook opens on, sheets depend on the
+			// workbook so it can not refer to a terraxcel_sheet
+			"active_sheet": schema.StringAttribute{
+				Optional: true,
+			},
+			// protects the structure of the workbook, so sheets can not be
+			// added, removed, renamed or moved
+			"protection": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"structure": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(true),
+					},
+					"windows": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+				},
+			},
+			// document properties shown under File > Info in Excel
+			"properties": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Optional: true,
+					},
+					"subject": schema.StringAttribute{
+						Optional: true,
+					},
+					"author": schema.StringAttribute{
+						Optional: true,
+					},
+					"manager": schema.StringAttribute{
+						Optional: true,
+					},
+					"company": schema.StringAttribute{
+						Optional: true,
+					},
+					"category": schema.StringAttribute{
+						Optional: true,
+					},
+					"keywords": schema.StringAttribute{
+						Optional: true,
+					},
+					"description": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			// custom properties, e.g. the git commit the workbook was
+			// built from
+			"custom_properties": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
@@ -71,21 +283,80 @@ func (r *workbookResource) Create(ctx context.Context, req resource.CreateReques
 	// convert plan to model
 	newWorkbook, err := models.NewWorkbook(plan.FileName.ValueString(), models.Extension(plan.Extension.ValueString()), plan.FolderPath.ValueString(), "")
 	if err != nil {
-		resp.Diagnostics.AddError("could not create workbook object from plan", fmt.Sprintf("could not create workbook object from plan, err: %w", err))
+		resp.Diagnostics.AddError("could not create workbook object from plan", fmt.Sprintf("could not create workbook object from plan, err: %s", err))
 		return
 	}
 
+	// reads the initial content before creating anything
+	var content *workbookContent
+	if !plan.TemplatePath.IsNull() {
+		template, err := os.ReadFile(plan.TemplatePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("could not read template", fmt.Sprintf("could not read template %s, err: %s", plan.TemplatePath.ValueString(), err))
+			return
+		}
+		content = &workbookContent{Content: template, Password: plan.Password.ValueString()}
+	}
+	if !plan.TemplateID.IsNull() {
+		content = &workbookContent{TemplateID: plan.TemplateID.ValueString(), Password: plan.Password.ValueString()}
+	}
+	if !plan.Source.IsNull() {
+		source, err := os.ReadFile(plan.Source.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("could not read source", fmt.Sprintf("could not read source %s, err: %s", plan.Source.ValueString(), err))
+			return
+		}
+		content = &workbookContent{Content: source, Password: plan.Password.ValueString()}
+	}
+
 	workbook, err := r.client.CreateWorkbook(newWorkbook)
 	if err != nil {
-		resp.Diagnostics.AddError("could not create workbook-file", fmt.Sprintf("could not create workbook-file, err: %w", err))
+		resp.Diagnostics.AddError("could not create workbook-file", fmt.Sprintf("could not create workbook-file, err: %s", err))
+		return
+	}
+
+	// removes the new workbook when any of the steps below fails, it would
+	// not be tracked in the state
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		err := deleteWorkbook(r.client, workbook.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("could not delete workbook", fmt.Sprintf("could not delete workbook with id %s after failing to create it, err: %s", workbook.ID, err))
+		}
+	}()
+
+	// replaces the empty workbook with the template or source
+	if content != nil {
+		err = updateWorkbookContent(r.client, workbook.ID, *content)
+		if err != nil {
+			resp.Diagnostics.AddError("could not upload workbook content", fmt.Sprintf("could not upload content of workbook with id %s, err: %s", workbook.ID, err))
+			return
+		}
+	}
+
+	settings, err := updateWorkbookSettings(r.client, workbook.ID, plan.settings())
+	if err != nil {
+		resp.Diagnostics.AddError("could not update workbook settings", fmt.Sprintf("could not update settings of workbook with id %s, err: %s", workbook.ID, err))
 		return
 	}
 
+	// encrypts the workbook once it has its content
+	if !plan.Password.IsNull() {
+		_, err = updateWorkbookEncryption(r.client, workbook.ID, &workbookEncryption{Password: plan.Password.ValueStringPointer()})
+		if err != nil {
+			resp.Diagnostics.AddError("could not encrypt workbook", fmt.Sprintf("could not encrypt workbook with id %s, err: %s", workbook.ID, err))
+			return
+		}
+	}
+
 	// map to state
 	plan.ID = types.StringValue(workbook.ID)
 	plan.FileName = types.StringValue(workbook.FileName)
 	plan.Extension = types.StringValue(string(workbook.Extension))
 	plan.FolderPath = types.StringValue(workbook.FolderPath)
+	plan.setSettings(settings)
 
 	// update last updated at
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
@@ -106,19 +377,44 @@ func (r *workbookResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	workbook, err := r.client.ReadWorkbook(state.ID.ValueString())
+	workbook, err := readWorkbook(r.client, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"error reading workbook",
-			fmt.Sprintf("could not read workbook with ID %s, err: %w", state.ID.ValueString(), err),
+			fmt.Sprintf("could not read workbook with ID %s, err: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	settings, err := readWorkbookSettings(r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"error reading workbook settings",
+			fmt.Sprintf("could not read settings of workbook with ID %s, err: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	encryption, err := readWorkbookEncryption(r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"error reading workbook encryption",
+			fmt.Sprintf("could not read encryption of workbook with ID %s, err: %s", state.ID.ValueString(), err),
 		)
 		return
 	}
 
+	// the password is never returned, it is only removed when the workbook
+	// was decrypted outside of terraform
+	if !encryption.Encrypted {
+		state.Password = types.StringNull()
+	}
+
 	state.ID = types.StringValue(workbook.ID)
 	state.FileName = types.StringValue(workbook.FileName)
 	state.Extension = types.StringValue(string(workbook.Extension))
 	state.FolderPath = types.StringValue(workbook.FolderPath)
+	state.setSettings(settings)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -136,19 +432,11 @@ func (r *workbookResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	// create workbook from state
-	workbook := &models.Workbook{
-		ID:         state.ID.ValueString(),
-		FileName:   state.FileName.ValueString(),
-		Extension:  models.Extension(state.Extension.ValueString()),
-		FolderPath: state.FolderPath.ValueString(),
-	}
-
-	err := r.client.DeleteWorkbook(*workbook)
+	err := deleteWorkbook(r.client, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"could not delete workbook",
-			fmt.Sprintf("could not delete workbook with id %s, unexpected error: %w", state.ID.ValueString(), err),
+			fmt.Sprintf("could not delete workbook with id %s, unexpected error: %s", state.ID.ValueString(), err),
 		)
 		return
 	}
@@ -169,38 +457,133 @@ func (r *workbookResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// the server falls back to creating an empty workbook if the file is
+	// missing, make sure it exists so the rename keeps the content
+	_, err := readWorkbook(r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"error updating workbook",
+			fmt.Sprintf("could not find existing workbook with id %s to rename, err: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// decrypts the workbook before converting it, as only xlsx and xlsm can
+	// be encrypted
+	if plan.Password.IsNull() && !state.Password.IsNull() {
+		_, err = updateWorkbookEncryption(r.client, state.ID.ValueString(), &workbookEncryption{CurrentPassword: state.Password.ValueStringPointer()})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"error decrypting workbook",
+				fmt.Sprintf("could not decrypt workbook with id %s, err: %s", state.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	// converts the content first, the rename below then moves the converted file
+	extension := models.Extension(plan.Extension.ValueString())
+	if !plan.Extension.Equal(state.Extension) {
+		_, err = convertWorkbook(r.client, state.ID.ValueString(), extension)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"error converting workbook",
+				fmt.Sprintf("could not convert workbook with id %s from %s to %s, err: %s", state.ID.ValueString(), state.Extension.ValueString(), extension, err),
+			)
+			return
+		}
+	}
+
 	// create new workbook
 	workbook := &models.Workbook{
 		ID:         state.ID.ValueString(),
 		FileName:   plan.FileName.ValueString(),
-		Extension:  models.Extension(plan.Extension.ValueString()),
+		Extension:  extension,
 		FolderPath: plan.FolderPath.ValueString(),
 	}
 
-	// update existing order
-	_, err := r.client.UpdateWorkbook(workbook)
+	// renames and/or moves the existing file
+	_, err = r.client.UpdateWorkbook(workbook)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"error updating workbook",
-			fmt.Sprintf("error when updating workbook with id %s, err: %w", workbook.ID, err),
+			fmt.Sprintf("error when updating workbook with id %s, err: %s", workbook.ID, err),
 		)
 		return
 	}
 
 	// reading the current state of the workbook after the update
-	workbook, err = r.client.ReadWorkbook(state.ID.ValueString())
+	updated, err := readWorkbook(r.client, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"error reading updated workbook",
-			fmt.Sprintf("error reading updated workbook with id %s, err: %w", state.ID.ValueString(), err),
+			fmt.Sprintf("error reading updated workbook with id %s, err: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// the workbook should have been moved, not recreated somewhere else
+	if updated.ID != workbook.ID || updated.GetFullPath() != workbook.GetFullPath() {
+		resp.Diagnostics.AddError(
+			"workbook was not moved",
+			fmt.Sprintf("expected workbook with id %s at %s, found it at %s", workbook.ID, workbook.GetFullPath(), updated.GetFullPath()),
 		)
+		return
+	}
+
+	// uploads the source again, after the workbook has its new extension
+	uploaded := !plan.Source.IsNull() && (!plan.Source.Equal(state.Source) || !plan.SourceHash.Equal(state.SourceHash))
+	if uploaded {
+		source, err := os.ReadFile(plan.Source.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("could not read source", fmt.Sprintf("could not read source %s, err: %s", plan.Source.ValueString(), err))
+			return
+		}
+
+		err = updateWorkbookContent(r.client, updated.ID, workbookContent{Content: source, Password: plan.Password.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"error uploading workbook content",
+				fmt.Sprintf("could not upload %s to workbook with id %s, err: %s", plan.Source.ValueString(), updated.ID, err),
+			)
+			return
+		}
+	}
+
+	settings, err := updateWorkbookSettings(r.client, updated.ID, plan.settings())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"error updating workbook settings",
+			fmt.Sprintf("could not update settings of workbook with id %s, err: %s", updated.ID, err),
+		)
+		return
+	}
+
+	// encrypts the workbook or rotates its password, after it has its new
+	// extension and content. An uploaded source is stored as is, so it is
+	// encrypted again even when the password did not change
+	if !plan.Password.IsNull() && (uploaded || !plan.Password.Equal(state.Password)) {
+		encryption := &workbookEncryption{Password: plan.Password.ValueStringPointer()}
+		if !uploaded {
+			encryption.CurrentPassword = state.Password.ValueStringPointer()
+		}
+
+		_, err = updateWorkbookEncryption(r.client, updated.ID, encryption)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"error encrypting workbook",
+				fmt.Sprintf("could not update the encryption of workbook with id %s, err: %s", updated.ID, err),
+			)
+			return
+		}
 	}
 
 	// update state
-	plan.ID = types.StringValue(workbook.ID)
-	plan.FileName = types.StringValue(workbook.FileName)
-	plan.Extension = types.StringValue(string(workbook.Extension))
-	plan.FolderPath = types.StringValue(workbook.FolderPath)
+	plan.ID = types.StringValue(updated.ID)
+	plan.FileName = types.StringValue(updated.FileName)
+	plan.Extension = types.StringValue(string(updated.Extension))
+	plan.FolderPath = types.StringValue(updated.FolderPath)
+	plan.setSettings(settings)
 
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -211,6 +594,146 @@ func (r *workbookResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 }
 
+// ModifyPlan makes sure the server can convert the workbook when the extension
+// changes, and warns about what is lost in the conversion.
+func (r *workbookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to convert when the workbook is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state workbookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan workbookResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Extension.IsUnknown() || plan.Extension.Equal(state.Extension) {
+		return
+	}
+
+	from := models.Extension(state.Extension.ValueString())
+	to := models.Extension(plan.Extension.ValueString())
+
+	// the provider may not be configured yet during validation
+	if r.client != nil {
+		extensions, err := readExtensions(r.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"error reading extensions",
+				fmt.Sprintf("could not read the extensions supported by the server, err: %s", err),
+			)
+			return
+		}
+
+		if !slices.Contains(extensions, string(to)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("extension"),
+				"unsupported extension",
+				fmt.Sprintf("the server can not convert workbooks to %s, supported extensions are: %s", to, strings.Join(extensions, ", ")),
+			)
+			return
+		}
+	}
+
+	for _, warning := range conversionWarnings(from, to) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("extension"),
+			fmt.Sprintf("converting workbook from %s to %s", from, to),
+			warning,
+		)
+	}
+}
+
+// ValidateConfig makes sure the workbook has at most one template or source,
+// and that local files and encryption can be used for the extension of the
+// workbook.
+func (r *workbookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config workbookResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TemplatePath.IsNull() && !config.TemplateID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("template_id"),
+			"conflicting templates",
+			"only one of template_path and template_id can be set",
+		)
+	}
+
+	if !config.Source.IsNull() && (!config.TemplatePath.IsNull() || !config.TemplateID.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"conflicting content",
+			"source can not be combined with template_path or template_id",
+		)
+	}
+
+	if config.Source.IsNull() && !config.SourceHash.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_hash"),
+			"missing source",
+			"source_hash can only be set together with source",
+		)
+	}
+
+	if resp.Diagnostics.HasError() || config.Extension.IsUnknown() {
+		return
+	}
+
+	extension := models.Extension(config.Extension.ValueString())
+
+	if !config.TemplatePath.IsNull() && !config.TemplatePath.IsUnknown() {
+		templateExtension := fileExtension(config.TemplatePath.ValueString())
+		if allowed, ok := templateExtensions[extension]; ok && !slices.Contains(allowed, templateExtension) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("template_path"),
+				"unsupported template",
+				fmt.Sprintf("a %s workbook can not be created from a %s file, supported templates are: %s", extension, templateExtension, strings.Join(allowed, ", ")),
+			)
+		}
+	}
+
+	if !config.Password.IsNull() && !slices.Contains(encryptionExtensions, extension) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"unsupported encryption",
+			fmt.Sprintf("a %s workbook can not be encrypted, only xlsx and xlsm workbooks can", extension),
+		)
+	}
+
+	if (config.Properties != nil || config.CustomProperties != nil) && !slices.Contains(propertiesExtensions, extension) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("properties"),
+			"unsupported properties",
+			fmt.Sprintf("a %s workbook has no document properties", extension),
+		)
+	}
+
+	// the source is uploaded as is, it is not converted
+	if !config.Source.IsNull() && !config.Source.IsUnknown() {
+		sourceExtension := fileExtension(config.Source.ValueString())
+		if sourceExtension != string(extension) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source"),
+				"unsupported source",
+				fmt.Sprintf("the source of a %s workbook must be a %s file, got a %s file", extension, extension, sourceExtension),
+			)
+		}
+	}
+}
+
 func (r *workbookResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
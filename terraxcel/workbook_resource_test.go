@@ -0,0 +1,608 @@
+package terraxcel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Deathfireofdoom/excel-client-go/pkg/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workbookServer serves a single workbook, which is renamed, moved and
+// converted in place like the TerraXcel server does.
+func workbookServer(t *testing.T, workbook models.Workbook) (*fakeServer, *workbookResource) {
+	server, c := newFakeServer(t)
+	endpoint := workbookEndpoint(workbook.ID)
+
+	server.handleFunc("GET "+endpoint, func([]byte) (int, interface{}) {
+		return http.StatusOK, workbook
+	})
+	server.handleFunc("PUT "+endpoint, func(body []byte) (int, interface{}) {
+		var updated models.Workbook
+		if err := json.Unmarshal(body, &updated); err != nil {
+			return http.StatusBadRequest, nil
+		}
+		workbook.FileName = updated.FileName
+		workbook.FolderPath = updated.FolderPath
+		return http.StatusOK, workbook
+	})
+	server.handleFunc("POST "+endpoint+"/convert", func(body []byte) (int, interface{}) {
+		var conversion workbookConversion
+		if err := json.Unmarshal(body, &conversion); err != nil {
+			return http.StatusBadRequest, nil
+		}
+		workbook.Extension = conversion.Extension
+		return http.StatusOK, workbook
+	})
+	server.handle("PUT "+endpoint+"/settings", http.StatusOK, workbookSettings{})
+
+	return server, &workbookResource{client: c}
+}
+
+func workbookModel(fileName, extension, folderPath string) workbookResourceModel {
+	return workbookResourceModel{
+		ID:         types.StringValue("wb-1"),
+		FileName:   types.StringValue(fileName),
+		Extension:  types.StringValue(extension),
+		FolderPath: types.StringValue(folderPath),
+	}
+}
+
+func TestWorkbookUpdate(t *testing.T) {
+	existing := models.Workbook{ID: "wb-1", FileName: "report", Extension: models.XLSX, FolderPath: "/reports"}
+
+	tests := []struct {
+		name      string
+		plan      workbookResourceModel
+		missing   bool
+		converted bool
+		wantError string
+	}{
+		{
+			name: "rename",
+			plan: workbookModel("summary", "xlsx", "/reports"),
+		},
+		{
+			name: "move",
+			plan: workbookModel("report", "xlsx", "/archive"),
+		},
+		{
+			name:      "extension change",
+			plan:      workbookModel("report", "xls", "/reports"),
+			converted: true,
+		},
+		{
+			name:      "missing file",
+			plan:      workbookModel("summary", "xlsx", "/reports"),
+			missing:   true,
+			wantError: "could not find existing workbook",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, r := workbookServer(t, existing)
+			if test.missing {
+				server.handle("GET "+workbookEndpoint("wb-1"), http.StatusNotFound, nil)
+			}
+
+			s := resourceSchema(t, r)
+			req := resource.UpdateRequest{
+				State: newState(t, s, workbookModel("report", "xlsx", "/reports")),
+				Plan:  newPlan(t, s, test.plan),
+			}
+			resp := &resource.UpdateResponse{State: newState(t, s, nil)}
+
+			r.Update(context.Background(), req, resp)
+
+			if test.wantError != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), test.wantError) {
+					t.Fatalf("expected error %q, got %v", test.wantError, resp.Diagnostics)
+				}
+				if server.received("PUT " + workbookEndpoint("wb-1")) {
+					t.Fatalf("a missing workbook must not be renamed, the server would create an empty one")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if got := server.received("POST " + workbookEndpoint("wb-1") + "/convert"); got != test.converted {
+				t.Errorf("converted = %v, want %v", got, test.converted)
+			}
+
+			var state workbookResourceModel
+			resp.State.Get(context.Background(), &state)
+			if !state.ID.Equal(types.StringValue("wb-1")) {
+				t.Errorf("id = %s, want the id of the existing workbook", state.ID)
+			}
+			if !state.FileName.Equal(test.plan.FileName) || !state.Extension.Equal(test.plan.Extension) || !state.FolderPath.Equal(test.plan.FolderPath) {
+				t.Errorf("state = %s/%s.%s, want %s/%s.%s", state.FolderPath, state.FileName, state.Extension,
+					test.plan.FolderPath, test.plan.FileName, test.plan.Extension)
+			}
+		})
+	}
+}
+
+func TestWorkbookUpdateNotMoved(t *testing.T) {
+	existing := models.Workbook{ID: "wb-1", FileName: "report", Extension: models.XLSX, FolderPath: "/reports"}
+	server, r := workbookServer(t, existing)
+
+	// the server ignores the rename
+	server.handle("PUT "+workbookEndpoint("wb-1"), http.StatusOK, existing)
+
+	s := resourceSchema(t, r)
+	req := resource.UpdateRequest{
+		State: newState(t, s, workbookModel("report", "xlsx", "/reports")),
+		Plan:  newPlan(t, s, workbookModel("summary", "xlsx", "/reports")),
+	}
+	resp := &resource.UpdateResponse{State: newState(t, s, nil)}
+
+	r.Update(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "workbook was not moved" {
+		t.Fatalf("expected the workbook not to be moved, got %v", resp.Diagnostics)
+	}
+}
+
+func TestWorkbookCreateCleanup(t *testing.T) {
+	tests := []struct {
+		name      string
+		failRoute string
+	}{
+		{name: "created"},
+		{name: "content upload fails", failRoute: "PUT " + workbookEndpoint("wb-1") + "/content"},
+		{name: "settings fail", failRoute: "PUT " + workbookEndpoint("wb-1") + "/settings"},
+		{name: "encryption fails", failRoute: "PUT " + workbookEndpoint("wb-1") + "/encryption"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, c := newFakeServer(t)
+			endpoint := workbookEndpoint("wb-1")
+			server.handle("POST /workbook", http.StatusCreated, models.Workbook{ID: "wb-1", FileName: "report", Extension: models.XLSX, FolderPath: "/reports"})
+			server.handle("PUT "+endpoint+"/content", http.StatusOK, nil)
+			server.handle("PUT "+endpoint+"/settings", http.StatusOK, workbookSettings{})
+			server.handle("PUT "+endpoint+"/encryption", http.StatusOK, workbookEncryption{Encrypted: true})
+			server.handle("DELETE "+endpoint, http.StatusOK, nil)
+			if test.failRoute != "" {
+				server.handle(test.failRoute, http.StatusInternalServerError, nil)
+			}
+
+			r := &workbookResource{client: c}
+			s := resourceSchema(t, r)
+			plan := workbookModel("report", "xlsx", "/reports")
+			plan.ID = types.StringUnknown()
+			plan.LastUpdated = types.StringUnknown()
+			plan.TemplateID = types.StringValue("corporate")
+			plan.Password = types.StringValue("secret")
+
+			resp := &resource.CreateResponse{State: newState(t, s, nil)}
+			r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, plan)}, resp)
+
+			deleted := server.received("DELETE " + endpoint)
+			if test.failRoute == "" {
+				if resp.Diagnostics.HasError() || deleted {
+					t.Fatalf("expected the workbook to be created, got deleted = %v, %v", deleted, resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected an error when %s fails", test.failRoute)
+			}
+			if !deleted {
+				t.Errorf("the workbook was left behind after %s failed", test.failRoute)
+			}
+		})
+	}
+}
+
+func TestWorkbookSetSettingsActiveSheet(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured types.String
+		reported   *string
+		want       types.String
+	}{
+		{"not configured", types.StringNull(), ptr("Sheet1"), types.StringNull()},
+		{"configured", types.StringValue("Summary"), ptr("Summary"), types.StringValue("Summary")},
+		{"changed outside of terraform", types.StringValue("Summary"), ptr("Data"), types.StringValue("Data")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			model := workbookResourceModel{ActiveSheet: test.configured}
+			model.setSettings(&workbookSettings{ActiveSheet: test.reported})
+			if !model.ActiveSheet.Equal(test.want) {
+				t.Errorf("active_sheet = %s, want %s", model.ActiveSheet, test.want)
+			}
+		})
+	}
+}
+
+func TestWorkbookUpdateEncryption(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "payroll.xlsx")
+	if err := os.WriteFile(source, []byte("payroll"), 0o600); err != nil {
+		t.Fatalf("could not write source: %s", err)
+	}
+
+	model := func(password, sourceHash string) workbookResourceModel {
+		m := workbookModel("payroll", "xlsx", "/hr")
+		m.Source = types.StringValue(source)
+		m.SourceHash = types.StringValue(sourceHash)
+		if password != "" {
+			m.Password = types.StringValue(password)
+		}
+		return m
+	}
+
+	tests := []struct {
+		name        string
+		state       workbookResourceModel
+		plan        workbookResourceModel
+		wantPUT     bool
+		want        workbookEncryption
+		wantContent bool
+	}{
+		{
+			name:    "encrypt",
+			state:   model("", "v1"),
+			plan:    model("secret", "v1"),
+			wantPUT: true,
+			want:    workbookEncryption{Password: ptr("secret")},
+		},
+		{
+			name:    "rotate",
+			state:   model("old", "v1"),
+			plan:    model("new", "v1"),
+			wantPUT: true,
+			want:    workbookEncryption{Password: ptr("new"), CurrentPassword: ptr("old")},
+		},
+		{
+			name:    "decrypt",
+			state:   model("secret", "v1"),
+			plan:    model("", "v1"),
+			wantPUT: true,
+			want:    workbookEncryption{CurrentPassword: ptr("secret")},
+		},
+		{
+			name:  "unchanged",
+			state: model("secret", "v1"),
+			plan:  model("secret", "v1"),
+		},
+		{
+			// the uploaded file is plain, it has no current password
+			name:        "new source with the same password",
+			state:       model("secret", "v1"),
+			plan:        model("secret", "v2"),
+			wantPUT:     true,
+			want:        workbookEncryption{Password: ptr("secret")},
+			wantContent: true,
+		},
+		{
+			name:        "new source with a new password",
+			state:       model("old", "v1"),
+			plan:        model("new", "v2"),
+			wantPUT:     true,
+			want:        workbookEncryption{Password: ptr("new")},
+			wantContent: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, r := workbookServer(t, models.Workbook{ID: "wb-1", FileName: "payroll", Extension: models.XLSX, FolderPath: "/hr"})
+			endpoint := workbookEndpoint("wb-1")
+			server.handle("PUT "+endpoint+"/content", http.StatusOK, nil)
+			server.handle("PUT "+endpoint+"/encryption", http.StatusOK, workbookEncryption{Encrypted: true})
+
+			s := resourceSchema(t, r)
+			req := resource.UpdateRequest{State: newState(t, s, test.state), Plan: newPlan(t, s, test.plan)}
+			resp := &resource.UpdateResponse{State: newState(t, s, nil)}
+
+			r.Update(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if got := server.received("PUT " + endpoint + "/content"); got != test.wantContent {
+				t.Errorf("uploaded content = %v, want %v", got, test.wantContent)
+			}
+			if got := server.received("PUT " + endpoint + "/encryption"); got != test.wantPUT {
+				t.Fatalf("updated encryption = %v, want %v", got, test.wantPUT)
+			}
+			if !test.wantPUT {
+				return
+			}
+
+			var sent workbookEncryption
+			server.decodeBody(t, "PUT "+endpoint+"/encryption", &sent)
+			if !equalPointer(sent.Password, test.want.Password) || !equalPointer(sent.CurrentPassword, test.want.CurrentPassword) {
+				t.Errorf("sent password %s, current password %s, want %s, %s",
+					pointerString(sent.Password), pointerString(sent.CurrentPassword),
+					pointerString(test.want.Password), pointerString(test.want.CurrentPassword))
+			}
+		})
+	}
+}
+
+func equalPointer(a, b *string) bool {
+	return (a == nil) == (b == nil) && (a == nil || *a == *b)
+}
+
+func pointerString(value *string) string {
+	if value == nil {
+		return "<nil>"
+	}
+	return *value
+}
+
+func TestWorkbookCreateSource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "forecast.xlsx")
+	if err := os.WriteFile(source, []byte("forecast"), 0o600); err != nil {
+		t.Fatalf("could not write source: %s", err)
+	}
+
+	server, c := newFakeServer(t)
+	endpoint := workbookEndpoint("wb-1")
+	server.handle("POST /workbook", http.StatusCreated, models.Workbook{ID: "wb-1", FileName: "forecast", Extension: models.XLSX, FolderPath: "/reports"})
+	server.handle("PUT "+endpoint+"/content", http.StatusOK, nil)
+	server.handle("PUT "+endpoint+"/settings", http.StatusOK, workbookSettings{})
+
+	r := &workbookResource{client: c}
+	s := resourceSchema(t, r)
+	plan := workbookModel("forecast", "xlsx", "/reports")
+	plan.ID = types.StringUnknown()
+	plan.LastUpdated = types.StringUnknown()
+	plan.Source = types.StringValue(source)
+	plan.SourceHash = types.StringValue("v1")
+
+	resp := &resource.CreateResponse{State: newState(t, s, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, plan)}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var sent workbookContent
+	server.decodeBody(t, "PUT "+endpoint+"/content", &sent)
+	if string(sent.Content) != "forecast" || sent.TemplateID != "" {
+		t.Errorf("uploaded %q from template %q, want the content of the source", sent.Content, sent.TemplateID)
+	}
+
+	var state workbookResourceModel
+	resp.State.Get(context.Background(), &state)
+	if !state.Source.Equal(plan.Source) || !state.SourceHash.Equal(plan.SourceHash) {
+		t.Errorf("state source = %s, %s, want %s, %s", state.Source, state.SourceHash, plan.Source, plan.SourceHash)
+	}
+}
+
+func TestWorkbookValidateConfigSource(t *testing.T) {
+	tests := []struct {
+		name       string
+		extension  string
+		source     types.String
+		sourceHash types.String
+		templateID types.String
+		wantError  string
+	}{
+		{
+			name:       "source",
+			extension:  "xlsx",
+			source:     types.StringValue("build/forecast.xlsx"),
+			sourceHash: types.StringValue("v1"),
+		},
+		{
+			name:      "unknown source",
+			extension: "xlsx",
+			source:    types.StringUnknown(),
+		},
+		{
+			name:       "source and template",
+			extension:  "xlsx",
+			source:     types.StringValue("build/forecast.xlsx"),
+			templateID: types.StringValue("corporate"),
+			wantError:  "conflicting content",
+		},
+		{
+			name:       "source hash without source",
+			extension:  "xlsx",
+			sourceHash: types.StringValue("v1"),
+			wantError:  "missing source",
+		},
+		{
+			name:      "source of another format",
+			extension: "xlsx",
+			source:    types.StringValue("build/forecast.csv"),
+			wantError: "unsupported source",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &workbookResource{}
+			s := resourceSchema(t, r)
+			config := workbookModel("forecast", test.extension, "/reports")
+			config.ID = types.StringNull()
+			config.Source = test.source
+			config.SourceHash = test.sourceHash
+			config.TemplateID = test.templateID
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newConfig(t, s, config)}, resp)
+
+			if test.wantError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !hasErrorSummary(resp.Diagnostics.Errors(), test.wantError) {
+				t.Fatalf("expected error %q, got %v", test.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// settingsServer serves a workbook whose settings are stored like the
+// TerraXcel server does, without the protection password.
+func settingsServer(t *testing.T) (*fakeServer, *workbookResource) {
+	server, c := newFakeServer(t)
+	endpoint := workbookEndpoint("wb-1")
+	workbook := models.Workbook{ID: "wb-1", FileName: "report", Extension: models.XLSX, FolderPath: "/reports"}
+
+	var stored workbookSettings
+	server.handle("POST /workbook", http.StatusCreated, workbook)
+	server.handle("GET "+endpoint, http.StatusOK, workbook)
+	server.handle("PUT "+endpoint, http.StatusOK, workbook)
+	server.handle("GET "+endpoint+"/encryption", http.StatusOK, workbookEncryption{})
+	server.handleFunc("PUT "+endpoint+"/settings", func(body []byte) (int, interface{}) {
+		stored = workbookSettings{}
+		if err := json.Unmarshal(body, &stored); err != nil {
+			return http.StatusBadRequest, nil
+		}
+		if stored.Protection != nil {
+			stored.Protection.Password = nil
+		}
+		// like the server, sets the author of workbooks without properties
+		if stored.Properties == nil {
+			stored.Properties = &documentProperties{Author: ptr("terraxcel")}
+		}
+		return http.StatusOK, stored
+	})
+	server.handleFunc("GET "+endpoint+"/settings", func([]byte) (int, interface{}) {
+		return http.StatusOK, stored
+	})
+
+	return server, &workbookResource{client: c}
+}
+
+// applyWorkbook creates the workbook from the plan and refreshes it, and
+// returns the refreshed state.
+func applyWorkbook(t *testing.T, r *workbookResource, plan workbookResourceModel) workbookResourceModel {
+	t.Helper()
+
+	s := resourceSchema(t, r)
+	plan.ID = types.StringUnknown()
+	plan.LastUpdated = types.StringUnknown()
+
+	createResp := &resource.CreateResponse{State: newState(t, s, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newPlan(t, s, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating the workbook: %v", createResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(context.Background(), resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading the workbook: %v", readResp.Diagnostics)
+	}
+
+	var state workbookResourceModel
+	readResp.State.Get(context.Background(), &state)
+	return state
+}
+
+func TestWorkbookProtectionRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		protection *workbookProtectionModel
+	}{
+		{name: "not protected"},
+		{
+			name: "structure with password",
+			protection: &workbookProtectionModel{
+				Password:  types.StringValue("secret"),
+				Structure: types.BoolValue(true),
+				Windows:   types.BoolNull(),
+			},
+		},
+		{
+			name: "windows without password",
+			protection: &workbookProtectionModel{
+				Password:  types.StringNull(),
+				Structure: types.BoolValue(false),
+				Windows:   types.BoolValue(true),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, r := settingsServer(t)
+			plan := workbookModel("report", "xlsx", "/reports")
+			plan.Protection = test.protection
+
+			state := applyWorkbook(t, r, plan)
+
+			var sent workbookSettings
+			server.decodeBody(t, "PUT "+workbookEndpoint("wb-1")+"/settings", &sent)
+			if test.protection != nil && !equalPointer(sent.Protection.Password, test.protection.Password.ValueStringPointer()) {
+				t.Errorf("sent password %s, want %s", pointerString(sent.Protection.Password), test.protection.Password)
+			}
+			if !reflect.DeepEqual(state.Protection, test.protection) {
+				t.Errorf("protection after refresh = %+v, want %+v", state.Protection, test.protection)
+			}
+		})
+	}
+}
+
+func TestWorkbookPropertiesRoundTrip(t *testing.T) {
+	tests := []struct {
+		name             string
+		properties       *documentPropertiesModel
+		customProperties map[string]types.String
+	}{
+		{name: "not configured"},
+		{
+			name: "document properties",
+			properties: &documentPropertiesModel{
+				Title:   types.StringValue("Quarterly report"),
+				Company: types.StringValue("Example Corp"),
+			},
+		},
+		{
+			name: "custom properties",
+			customProperties: map[string]types.String{
+				"commit":      types.StringValue("3f2c1a9"),
+				"environment": types.StringValue("production"),
+			},
+		},
+		{
+			name:             "no custom properties",
+			customProperties: map[string]types.String{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, r := settingsServer(t)
+			plan := workbookModel("report", "xlsx", "/reports")
+			plan.Properties = test.properties
+			plan.CustomProperties = test.customProperties
+
+			state := applyWorkbook(t, r, plan)
+
+			var sent workbookSettings
+			server.decodeBody(t, "PUT "+workbookEndpoint("wb-1")+"/settings", &sent)
+			if len(sent.CustomProperties) != len(test.customProperties) {
+				t.Errorf("sent custom properties %v, want %v", sent.CustomProperties, test.customProperties)
+			}
+			if !reflect.DeepEqual(state.Properties, test.properties) {
+				t.Errorf("properties after refresh = %+v, want %+v", state.Properties, test.properties)
+			}
+			if !reflect.DeepEqual(state.CustomProperties, test.customProperties) {
+				t.Errorf("custom properties after refresh = %v, want %v", state.CustomProperties, test.customProperties)
+			}
+		})
+	}
+}